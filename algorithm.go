@@ -0,0 +1,121 @@
+package intrusive
+
+// Apply f to every element of container in order.
+//
+// Next element is obtained before f is called so f MAY unlink its argument
+func ForEach[T any](c Iterable[T], f func(element *T)) {
+	for e := c.Front(); e != nil; {
+		next := c.Next(e)
+		f(e)
+		e = next
+	}
+}
+
+// Return first element satisfying predicate or nil if there is no such element
+func FindIf[T any](c Iterable[T], predicate func(element *T) bool) *T {
+	for e := c.Front(); e != nil; e = c.Next(e) {
+		if predicate(e) {
+			return e
+		}
+	}
+	return nil
+}
+
+// Return number of elements satisfying predicate
+func CountIf[T any](c Iterable[T], predicate func(element *T) bool) (count int) {
+	for e := c.Front(); e != nil; e = c.Next(e) {
+		if predicate(e) {
+			count++
+		}
+	}
+	return
+}
+
+// Check if at least one element satisfies predicate
+func AnyOf[T any](c Iterable[T], predicate func(element *T) bool) bool {
+	return FindIf(c, predicate) != nil
+}
+
+// Check if all elements satisfy predicate. Return true for empty container
+func AllOf[T any](c Iterable[T], predicate func(element *T) bool) bool {
+	return FindIf(c, func(element *T) bool { return !predicate(element) }) == nil
+}
+
+// Check if no element satisfies predicate. Return true for empty container
+func NoneOf[T any](c Iterable[T], predicate func(element *T) bool) bool {
+	return FindIf(c, predicate) == nil
+}
+
+// Return all elements of container in order as slice. Elements stay linked
+func Collect[T any](c Iterable[T]) (elements []*T) {
+	elements = make([]*T, 0)
+	for e := c.Front(); e != nil; e = c.Next(e) {
+		elements = append(elements, e)
+	}
+	return
+}
+
+// Check if two containers have the same number of elements and elements at same positions are equal
+func Equal[T any, U any](lhs Iterable[T], rhs Iterable[U], equal func(lhs *T, rhs *U) bool) bool {
+	l, r := lhs.Front(), rhs.Front()
+	for l != nil && r != nil {
+		if !equal(l, r) {
+			return false
+		}
+		l, r = lhs.Next(l), rhs.Next(r)
+	}
+	return l == nil && r == nil
+}
+
+// Check if elements of container are in non-descending order
+func IsSorted[T any](c Iterable[T], less func(lhs, rhs *T) bool) bool {
+	prev := c.Front()
+	if prev == nil {
+		return true
+	}
+	for e := c.Next(prev); e != nil; prev, e = e, c.Next(e) {
+		if less(e, prev) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return the first smallest element or nil if container is empty
+func MinElement[T any](c Iterable[T], less func(lhs, rhs *T) bool) (min *T) {
+	for e := c.Front(); e != nil; e = c.Next(e) {
+		if min == nil || less(e, min) {
+			min = e
+		}
+	}
+	return
+}
+
+// Return the first largest element or nil if container is empty
+func MaxElement[T any](c Iterable[T], less func(lhs, rhs *T) bool) (max *T) {
+	for e := c.Front(); e != nil; e = c.Next(e) {
+		if max == nil || less(max, e) {
+			max = e
+		}
+	}
+	return
+}
+
+// Move all elements from the front of src to the back of dst preserving their order.
+// Containers MAY be of different types if elements have hooks for both of them. Dst MUST NOT be src
+func MoveAll[T any](dst, src Sequence[T]) {
+	for e := src.PopFront(); e != nil; e = src.PopFront() {
+		dst.PushBack(e)
+	}
+}
+
+// Insert all elements into set and return elements that were rejected as duplicates
+func InsertAll[T any](set OrderedSet[T], elements []*T) (rejected []*T) {
+	rejected = make([]*T, 0)
+	for _, e := range elements {
+		if !set.Insert(e) {
+			rejected = append(rejected, e)
+		}
+	}
+	return
+}
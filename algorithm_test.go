@@ -0,0 +1,164 @@
+package intrusive
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/rbtree"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+type testItem struct {
+	sHook slist.Hook[testItem]
+	dHook dlist.Hook[testItem]
+	tHook rbtree.Hook[testItem]
+	value int
+}
+
+func sHook(self *testItem) *slist.Hook[testItem] {
+	return &self.sHook
+}
+
+func dHook(self *testItem) *dlist.Hook[testItem] {
+	return &self.dHook
+}
+
+func tHook(self *testItem) *rbtree.Hook[testItem] {
+	return &self.tHook
+}
+
+func less(lhs, rhs *testItem) bool {
+	return lhs.value < rhs.value
+}
+
+func equal(lhs, rhs *testItem) bool {
+	return lhs.value == rhs.value
+}
+
+func isEven(e *testItem) bool {
+	return e.value%2 == 0
+}
+
+func newItems(values ...int) []*testItem {
+	items := make([]*testItem, len(values))
+	for i, v := range values {
+		items[i] = &testItem{value: v}
+	}
+	return items
+}
+
+func values[T any](c Iterable[T], value func(*T) int) []int {
+	v := make([]int, 0)
+	ForEach(c, func(e *T) { v = append(v, value(e)) })
+	return v
+}
+
+func itemValue(e *testItem) int {
+	return e.value
+}
+
+// Every container is filled with the same elements in the same order
+func newContainers(values ...int) (*slist.SList[testItem], *dlist.DList[testItem], *rbtree.RbTree[testItem]) {
+	s, d, t := slist.New(sHook), dlist.New(dHook), rbtree.New(tHook, less)
+	for _, e := range newItems(values...) {
+		s.PushBack(e)
+		d.PushBack(e)
+		t.Insert(e)
+	}
+	return &s, &d, &t
+}
+
+func TestAlgorithmsOnEmptyContainers(t *testing.T) {
+	s, d, tree := newContainers()
+	for name, c := range map[string]Iterable[testItem]{"slist": s, "dlist": d, "rbtree": tree} {
+		if FindIf(c, isEven) != nil || CountIf(c, isEven) != 0 || AnyOf(c, isEven) {
+			t.Errorf("%v: predicate is satisfied by empty container", name)
+		}
+		if !AllOf(c, isEven) || !NoneOf(c, isEven) || !IsSorted(c, less) {
+			t.Errorf("%v: empty container does not satisfy vacuous predicate", name)
+		}
+		if MinElement(c, less) != nil || MaxElement(c, less) != nil || len(Collect(c)) != 0 {
+			t.Errorf("%v: empty container has elements", name)
+		}
+	}
+}
+
+func TestAlgorithmsOnAllContainers(t *testing.T) {
+	s, d, tree := newContainers(1, 3, 4, 6, 9)
+	for name, c := range map[string]Iterable[testItem]{"slist": s, "dlist": d, "rbtree": tree} {
+		if v := values(c, itemValue); !slices.Equal(v, []int{1, 3, 4, 6, 9}) {
+			t.Errorf("%v: foreach visited %v", name, v)
+		}
+		if e := FindIf(c, isEven); e == nil || e.value != 4 {
+			t.Errorf("%v: findif returned %v", name, e)
+		}
+		if n := CountIf(c, isEven); n != 2 {
+			t.Errorf("%v: countif returned %v", name, n)
+		}
+		if !AnyOf(c, isEven) || AllOf(c, isEven) || NoneOf(c, isEven) {
+			t.Errorf("%v: unexpected result of predicates", name)
+		}
+		if !IsSorted(c, less) {
+			t.Errorf("%v: sorted container is not sorted", name)
+		}
+		if MinElement(c, less).value != 1 || MaxElement(c, less).value != 9 {
+			t.Errorf("%v: unexpected min or max element", name)
+		}
+		if len(Collect(c)) != 5 {
+			t.Errorf("%v: collect returned unexpected number of elements", name)
+		}
+	}
+	if !Equal[testItem, testItem](s, d, equal) || !Equal[testItem, testItem](d, tree, equal) {
+		t.Errorf("containers with same elements are not equal")
+	}
+}
+
+func TestIsSortedAndEqualDetectDifferences(t *testing.T) {
+	s, _, _ := newContainers(1, 3, 2)
+	d, _, _ := newContainers(1, 3)
+	if IsSorted(s, less) {
+		t.Errorf("unsorted list is sorted")
+	}
+	if Equal[testItem, testItem](s, d, equal) || Equal[testItem, testItem](d, s, equal) {
+		t.Errorf("lists of different size are equal")
+	}
+}
+
+func TestForEachAllowsUnlink(t *testing.T) {
+	_, d, _ := newContainers(0, 1, 2, 3, 4)
+	ForEach(d, func(e *testItem) {
+		if isEven(e) {
+			d.Erase(e)
+		}
+	})
+	if v := values(d, itemValue); !slices.Equal(v, []int{1, 3}) {
+		t.Errorf("list after erase in foreach has order %v", v)
+	}
+}
+
+func TestMoveAllBetweenSequences(t *testing.T) {
+	s := slist.New(sHook)
+	d := dlist.New(dHook)
+	for _, e := range newItems(0, 1, 2) {
+		s.PushBack(e)
+	}
+	MoveAll[testItem](&d, &s)
+	if !s.Empty() || d.Len() != 3 {
+		t.Errorf("unexpected sizes after move %v %v", s.Len(), d.Len())
+	}
+	if v := values(&d, itemValue); !slices.Equal(v, []int{0, 1, 2}) {
+		t.Errorf("moved elements have order %v", v)
+	}
+}
+
+func TestInsertAllRejectsDuplicates(t *testing.T) {
+	tree := rbtree.New(tHook, less)
+	rejected := InsertAll[testItem](&tree, newItems(2, 1, 2, 3, 1))
+	if tree.Len() != 3 || len(rejected) != 2 {
+		t.Errorf("unexpected number of inserted %v and rejected %v elements", tree.Len(), len(rejected))
+	}
+	if rejected[0].value != 2 || rejected[1].value != 1 {
+		t.Errorf("unexpected elements rejected")
+	}
+}
@@ -0,0 +1,19 @@
+package intrusive
+
+import (
+	"github.com/echo-Mike/intrusive/btree"
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/rbtree"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+// Compile-time assertions that containers implement common interfaces
+var (
+	_ Sequence[struct{}]   = (*slist.SList[struct{}])(nil)
+	_ Sized                = (*slist.Circular[struct{}])(nil)
+	_ Sized                = (*slist.Linear[struct{}])(nil)
+	_ Deque[struct{}]      = (*dlist.DList[struct{}])(nil)
+	_ Sized                = (*dlist.Ring[struct{}])(nil)
+	_ OrderedSet[struct{}] = (*rbtree.RbTree[struct{}])(nil)
+	_ OrderedSet[struct{}] = (*btree.BTree[struct{}])(nil)
+)
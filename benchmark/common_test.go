@@ -0,0 +1,79 @@
+package benchmark
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/btree"
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/rbtree"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+// Element type that can be linked into every intrusive container at once
+type item struct {
+	value int
+	sHook slist.Hook[item]
+	dHook dlist.Hook[item]
+	tHook rbtree.Hook[item]
+	bHook btree.Hook[item]
+}
+
+func sHook(self *item) *slist.Hook[item] {
+	return &self.sHook
+}
+
+func dHook(self *item) *dlist.Hook[item] {
+	return &self.dHook
+}
+
+func tHook(self *item) *rbtree.Hook[item] {
+	return &self.tHook
+}
+
+func bHook(self *item) *btree.Hook[item] {
+	return &self.bHook
+}
+
+func less(lhs, rhs *item) bool {
+	return lhs.value < rhs.value
+}
+
+func compare(lhs, rhs *item) int {
+	return cmp.Compare(lhs.value, rhs.value)
+}
+
+var sizes = []int{16, 1024, 65536}
+
+// Return items with values that are random permutation of [0, size)
+func newItems(size int) []item {
+	items := make([]item, size)
+	for i, v := range rand.Perm(size) {
+		items[i].value = v
+	}
+	return items
+}
+
+// Return values that are random permutation of [0, size)
+func newValues(size int) []int {
+	return rand.Perm(size)
+}
+
+// Reset hooks of items so they can be linked again
+func resetItems(items []item) {
+	for i := range items {
+		items[i] = item{value: items[i].value}
+	}
+}
+
+// Run f as sub-benchmark for every size
+func forSizes(b *testing.B, name string, f func(b *testing.B, size int)) {
+	for _, size := range sizes {
+		b.Run(fmt.Sprintf("%s/%d", name, size), func(b *testing.B) {
+			b.ReportAllocs()
+			f(b, size)
+		})
+	}
+}
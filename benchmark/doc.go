@@ -0,0 +1,10 @@
+// Package benchmark contains benchmarks of intrusive containers compared against
+// containers of standard library: container/list, sorted slices and maps.
+//
+// Package has no API, run benchmarks with
+//
+//	go test -run XXX -bench . -benchmem ./benchmark
+//
+// Every benchmark is run for several sizes of containers. Elements are allocated
+// before timer is started, so reported allocations are allocations of containers themselves
+package benchmark
@@ -0,0 +1,171 @@
+package benchmark
+
+import (
+	"container/list"
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+func BenchmarkPushPop(b *testing.B) {
+	forSizes(b, "SList", func(b *testing.B, size int) {
+		items := newItems(size)
+		l := slist.New(sHook)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := range items {
+				l.PushBack(&items[j])
+			}
+			for !l.Empty() {
+				l.PopFront()
+			}
+		}
+	})
+	forSizes(b, "DList", func(b *testing.B, size int) {
+		items := newItems(size)
+		l := dlist.New(dHook)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := range items {
+				l.PushBack(&items[j])
+			}
+			for !l.Empty() {
+				l.PopFront()
+			}
+		}
+	})
+	forSizes(b, "container-list", func(b *testing.B, size int) {
+		items := newItems(size)
+		l := list.New()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := range items {
+				l.PushBack(&items[j])
+			}
+			for l.Len() != 0 {
+				l.Remove(l.Front())
+			}
+		}
+	})
+	forSizes(b, "slice", func(b *testing.B, size int) {
+		items := newItems(size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var s []*item
+			for j := range items {
+				s = append(s, &items[j])
+			}
+			for len(s) != 0 {
+				s = s[1:]
+			}
+		}
+	})
+}
+
+func BenchmarkSort(b *testing.B) {
+	forSizes(b, "SList", func(b *testing.B, size int) {
+		items := newItems(size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			resetItems(items)
+			l := slist.New(sHook)
+			for j := range items {
+				l.PushBack(&items[j])
+			}
+			b.StartTimer()
+			l.Sort(less)
+		}
+	})
+	forSizes(b, "DList", func(b *testing.B, size int) {
+		items := newItems(size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			resetItems(items)
+			l := dlist.New(dHook)
+			for j := range items {
+				l.PushBack(&items[j])
+			}
+			b.StartTimer()
+			l.SortFunc(compare)
+		}
+	})
+	forSizes(b, "slice", func(b *testing.B, size int) {
+		items := newItems(size)
+		s := make([]*item, size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			for j := range items {
+				s[j] = &items[j]
+			}
+			b.StartTimer()
+			slices.SortStableFunc(s, compare)
+		}
+	})
+}
+
+func BenchmarkMerge(b *testing.B) {
+	// Merge two sorted halves: even and odd values
+	forSizes(b, "SList", func(b *testing.B, size int) {
+		items := newItems(size)
+		slices.SortFunc(items, func(lhs, rhs item) int { return compare(&lhs, &rhs) })
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			resetItems(items)
+			l, other := slist.New(sHook), slist.New(sHook)
+			for j := range items {
+				if j%2 == 0 {
+					l.PushBack(&items[j])
+				} else {
+					other.PushBack(&items[j])
+				}
+			}
+			b.StartTimer()
+			l.Merge(&other, less)
+		}
+	})
+	forSizes(b, "DList", func(b *testing.B, size int) {
+		items := newItems(size)
+		slices.SortFunc(items, func(lhs, rhs item) int { return compare(&lhs, &rhs) })
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			resetItems(items)
+			l, other := dlist.New(dHook), dlist.New(dHook)
+			for j := range items {
+				if j%2 == 0 {
+					l.PushBack(&items[j])
+				} else {
+					other.PushBack(&items[j])
+				}
+			}
+			b.StartTimer()
+			l.Merge(&other, less)
+		}
+	})
+	forSizes(b, "slice", func(b *testing.B, size int) {
+		items := newItems(size)
+		slices.SortFunc(items, func(lhs, rhs item) int { return compare(&lhs, &rhs) })
+		lhs, rhs := make([]*item, 0, size), make([]*item, 0, size)
+		for j := range items {
+			if j%2 == 0 {
+				lhs = append(lhs, &items[j])
+			} else {
+				rhs = append(rhs, &items[j])
+			}
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			merged := make([]*item, 0, size)
+			l, r := lhs, rhs
+			for len(l) != 0 && len(r) != 0 {
+				if less(r[0], l[0]) {
+					merged, r = append(merged, r[0]), r[1:]
+				} else {
+					merged, l = append(merged, l[0]), l[1:]
+				}
+			}
+			merged = append(append(merged, l...), r...)
+		}
+	})
+}
@@ -0,0 +1,290 @@
+package benchmark
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/btree"
+	"github.com/echo-Mike/intrusive/rbtree"
+)
+
+// Sorted slice based set used as a baseline for RbTree
+type sortedSlice []*item
+
+func (s *sortedSlice) insert(e *item) bool {
+	i, found := slices.BinarySearchFunc(*s, e, compare)
+	if found {
+		return false
+	}
+	*s = slices.Insert(*s, i, e)
+	return true
+}
+
+func (s sortedSlice) find(e *item) *item {
+	if i, found := slices.BinarySearchFunc(s, e, compare); found {
+		return s[i]
+	}
+	return nil
+}
+
+func (s *sortedSlice) erase(e *item) bool {
+	i, found := slices.BinarySearchFunc(*s, e, compare)
+	if found {
+		*s = slices.Delete(*s, i, i+1)
+	}
+	return found
+}
+
+func BenchmarkInsert(b *testing.B) {
+	forSizes(b, "RbTree", func(b *testing.B, size int) {
+		items := newItems(size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			resetItems(items)
+			t := rbtree.New(tHook, less)
+			b.StartTimer()
+			for j := range items {
+				t.Insert(&items[j])
+			}
+		}
+	})
+	forSizes(b, "BTree", func(b *testing.B, size int) {
+		items := newItems(size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			resetItems(items)
+			t := btree.New(bHook, less)
+			b.StartTimer()
+			for j := range items {
+				t.Insert(&items[j])
+			}
+		}
+	})
+	forSizes(b, "sorted-slice", func(b *testing.B, size int) {
+		items := newItems(size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			s := sortedSlice{}
+			for j := range items {
+				s.insert(&items[j])
+			}
+		}
+	})
+	forSizes(b, "map", func(b *testing.B, size int) {
+		items := newItems(size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			m := map[int]*item{}
+			for j := range items {
+				m[items[j].value] = &items[j]
+			}
+		}
+	})
+}
+
+func BenchmarkFind(b *testing.B) {
+	forSizes(b, "RbTree", func(b *testing.B, size int) {
+		items := newItems(size)
+		t := rbtree.New(tHook, less)
+		for j := range items {
+			t.Insert(&items[j])
+		}
+		keys := newItems(size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := range keys {
+				t.Find(&keys[j])
+			}
+		}
+	})
+	forSizes(b, "BTree", func(b *testing.B, size int) {
+		items := newItems(size)
+		t := btree.New(bHook, less)
+		for j := range items {
+			t.Insert(&items[j])
+		}
+		keys := newItems(size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := range keys {
+				t.Find(&keys[j])
+			}
+		}
+	})
+	forSizes(b, "sorted-slice", func(b *testing.B, size int) {
+		items := newItems(size)
+		s := sortedSlice{}
+		for j := range items {
+			s.insert(&items[j])
+		}
+		keys := newItems(size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for j := range keys {
+				s.find(&keys[j])
+			}
+		}
+	})
+	forSizes(b, "map", func(b *testing.B, size int) {
+		items := newItems(size)
+		m := map[int]*item{}
+		for j := range items {
+			m[items[j].value] = &items[j]
+		}
+		keys := newValues(size)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, k := range keys {
+				_ = m[k]
+			}
+		}
+	})
+}
+
+func BenchmarkErase(b *testing.B) {
+	forSizes(b, "RbTree", func(b *testing.B, size int) {
+		items := newItems(size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			resetItems(items)
+			t := rbtree.New(tHook, less)
+			for j := range items {
+				t.Insert(&items[j])
+			}
+			b.StartTimer()
+			for j := range items {
+				t.Erase(&items[j])
+			}
+		}
+	})
+	forSizes(b, "BTree", func(b *testing.B, size int) {
+		items := newItems(size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			resetItems(items)
+			t := btree.New(bHook, less)
+			for j := range items {
+				t.Insert(&items[j])
+			}
+			b.StartTimer()
+			for j := range items {
+				t.Erase(&items[j])
+			}
+		}
+	})
+	forSizes(b, "sorted-slice", func(b *testing.B, size int) {
+		items := newItems(size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			s := sortedSlice{}
+			for j := range items {
+				s.insert(&items[j])
+			}
+			b.StartTimer()
+			for j := range items {
+				s.erase(&items[j])
+			}
+		}
+	})
+	forSizes(b, "map", func(b *testing.B, size int) {
+		items := newItems(size)
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			m := map[int]*item{}
+			for j := range items {
+				m[items[j].value] = &items[j]
+			}
+			b.StartTimer()
+			for j := range items {
+				delete(m, items[j].value)
+			}
+		}
+	})
+}
+
+// Return two sets of items: multiples of two and multiples of three in [0, 2*size)
+func newSetItems(size int) (lhs, rhs []item) {
+	lhs, rhs = make([]item, 0, size), make([]item, 0, size)
+	for v := 0; v < 2*size; v++ {
+		if v%2 == 0 {
+			lhs = append(lhs, item{value: v})
+		}
+		if v%3 == 0 {
+			rhs = append(rhs, item{value: v})
+		}
+	}
+	return
+}
+
+func BenchmarkSetOperations(b *testing.B) {
+	forSizes(b, "RbTree", func(b *testing.B, size int) {
+		lhsItems, rhsItems := newSetItems(size)
+		lhs, rhs := rbtree.New(tHook, less), rbtree.New(tHook, less)
+		for j := range lhsItems {
+			lhs.Insert(&lhsItems[j])
+		}
+		for j := range rhsItems {
+			rhs.Insert(&rhsItems[j])
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			lhs.Union(&rhs)
+			lhs.Intersection(&rhs)
+			lhs.Difference(&rhs)
+		}
+	})
+	forSizes(b, "sorted-slice", func(b *testing.B, size int) {
+		lhsItems, rhsItems := newSetItems(size)
+		lhs, rhs := sortedSlice{}, sortedSlice{}
+		for j := range lhsItems {
+			lhs.insert(&lhsItems[j])
+		}
+		for j := range rhsItems {
+			rhs.insert(&rhsItems[j])
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var union, intersection, difference []*item
+			l, r := lhs, rhs
+			for len(l) != 0 && len(r) != 0 {
+				switch compare(l[0], r[0]) {
+				case -1:
+					union, difference, l = append(union, l[0]), append(difference, l[0]), l[1:]
+				case 1:
+					union, r = append(union, r[0]), r[1:]
+				default:
+					union, intersection, l, r = append(union, l[0]), append(intersection, l[0]), l[1:], r[1:]
+				}
+			}
+			_ = append(append(union, l...), r...)
+			_ = append(difference, l...)
+		}
+	})
+	forSizes(b, "map", func(b *testing.B, size int) {
+		lhsItems, rhsItems := newSetItems(size)
+		lhs, rhs := map[int]*item{}, map[int]*item{}
+		for j := range lhsItems {
+			lhs[lhsItems[j].value] = &lhsItems[j]
+		}
+		for j := range rhsItems {
+			rhs[rhsItems[j].value] = &rhsItems[j]
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			var union, intersection, difference []*item
+			for k, v := range lhs {
+				union = append(union, v)
+				if _, found := rhs[k]; found {
+					intersection = append(intersection, v)
+				} else {
+					difference = append(difference, v)
+				}
+			}
+			for k, v := range rhs {
+				if _, found := lhs[k]; !found {
+					union = append(union, v)
+				}
+			}
+		}
+	})
+}
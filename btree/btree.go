@@ -0,0 +1,478 @@
+// Package btree implements intrusive B+ tree: an ordered container with API of
+// RbTree whose nodes hold up to maxItems entries, so search visits few nodes
+// instead of chasing a pointer on every level of a binary tree.
+//
+// Internal nodes and leaves are allocated by the tree. Leaves reference user
+// elements and Hook of an element points back to its leaf and position in it,
+// so Erase, Next and Prev locate an element in constant time. Leaves are linked
+// in order for traversal
+package btree
+
+import (
+	"slices"
+)
+
+const (
+	// Maximum number of entries in a node
+	maxItems = 32
+	// Minimum number of entries in a node other than the root
+	minItems = maxItems / 2
+)
+
+type (
+	// Hook contains position of an element in a leaf of a tree
+	Hook[T any] struct {
+		leaf  *node[T]
+		index int
+	}
+
+	// Node of a tree. Leaf holds elements in order, internal node holds children
+	// and the smallest element of every child
+	node[T any] struct {
+		parent     *node[T]
+		items      []*T
+		children   []*node[T]
+		prev, next *node[T]
+	}
+
+	// BTree implements a B+ tree data structure.
+	// This structure have a set semantic - meaning the total order
+	// of element as compared by lessFunc should not change while
+	// it is inside tree
+	BTree[T any] struct {
+		hookFunc    func(*T) *Hook[T]
+		lessFunc    func(*T, *T) bool
+		root        *node[T]
+		first, last *node[T]
+		size        int
+	}
+)
+
+// Initialize hook to empty state.
+//
+// WARNING: Calling this function on linked Hook will damage BTree structure
+func (h *Hook[T]) Init() {
+	h.leaf = nil
+	h.index = 0
+}
+
+// NewHook creates a new initialized Hook
+func NewHook[T any]() Hook[T] {
+	return Hook[T]{leaf: nil, index: 0}
+}
+
+// New creates a new B+ tree
+func New[T any](hookFunc func(*T) *Hook[T], lessFunc func(*T, *T) bool) BTree[T] {
+	return BTree[T]{
+		hookFunc: hookFunc,
+		lessFunc: lessFunc,
+	}
+}
+
+func newNode[T any](parent *node[T], leaf bool) *node[T] {
+	n := &node[T]{parent: parent, items: make([]*T, 0, maxItems+1)}
+	if !leaf {
+		n.children = make([]*node[T], 0, maxItems+1)
+	}
+	return n
+}
+
+func (n *node[T]) isLeaf() bool {
+	return n.children == nil
+}
+
+// Return index of the first entry not less than item
+func (t *BTree[T]) lower(items []*T, item *T) int {
+	i, j := 0, len(items)
+	for i < j {
+		if m := int(uint(i+j) >> 1); t.lessFunc(items[m], item) {
+			i = m + 1
+		} else {
+			j = m
+		}
+	}
+	return i
+}
+
+// Return index of the first entry greater than item
+func (t *BTree[T]) upper(items []*T, item *T) int {
+	i, j := 0, len(items)
+	for i < j {
+		if m := int(uint(i+j) >> 1); !t.lessFunc(item, items[m]) {
+			i = m + 1
+		} else {
+			j = m
+		}
+	}
+	return i
+}
+
+// Return leaf where item is or should be. Tree MUST NOT be empty
+func (t *BTree[T]) descend(item *T) *node[T] {
+	n := t.root
+	for !n.isLeaf() {
+		n = n.children[max(t.upper(n.items, item)-1, 0)]
+	}
+	return n
+}
+
+// Update hooks of elements of leaf starting from index
+func (t *BTree[T]) reindex(leaf *node[T], from int) {
+	for i := from; i < len(leaf.items); i++ {
+		hook := t.hookFunc(leaf.items[i])
+		hook.leaf = leaf
+		hook.index = i
+	}
+}
+
+// Return index of node among children of its parent
+func (t *BTree[T]) childIndex(n *node[T]) int {
+	return slices.Index(n.parent.children, n)
+}
+
+// Propagate the smallest entry of node to ancestors
+func (t *BTree[T]) updateMin(n *node[T]) {
+	for n.parent != nil {
+		i := t.childIndex(n)
+		n.parent.items[i] = n.items[0]
+		if i != 0 {
+			return
+		}
+		n = n.parent
+	}
+}
+
+// Move upper half of overfull node into a new node and link it to parent
+func (t *BTree[T]) split(n *node[T]) {
+	mid := len(n.items) / 2
+	right := newNode(n.parent, n.isLeaf())
+	right.items = append(right.items, n.items[mid:]...)
+	clear(n.items[mid:])
+	n.items = n.items[:mid]
+	if n.isLeaf() {
+		t.reindex(right, 0)
+		right.prev, right.next = n, n.next
+		if n.next != nil {
+			n.next.prev = right
+		} else {
+			t.last = right
+		}
+		n.next = right
+	} else {
+		right.children = append(right.children, n.children[mid:]...)
+		clear(n.children[mid:])
+		n.children = n.children[:mid]
+		for _, child := range right.children {
+			child.parent = right
+		}
+	}
+
+	if n.parent == nil {
+		root := newNode[T](nil, false)
+		root.items = append(root.items, n.items[0], right.items[0])
+		root.children = append(root.children, n, right)
+		n.parent, right.parent = root, root
+		t.root = root
+		return
+	}
+	p := n.parent
+	i := t.childIndex(n) + 1
+	p.items = slices.Insert(p.items, i, right.items[0])
+	p.children = slices.Insert(p.children, i, right)
+	if len(p.items) > maxItems {
+		t.split(p)
+	}
+}
+
+// Move the last entry of left sibling to the front of child i of p
+func (t *BTree[T]) borrowLeft(p *node[T], i int) {
+	left, n := p.children[i-1], p.children[i]
+	last := len(left.items) - 1
+	n.items = slices.Insert(n.items, 0, left.items[last])
+	left.items[last] = nil
+	left.items = left.items[:last]
+	if n.isLeaf() {
+		t.reindex(n, 0)
+	} else {
+		child := left.children[last]
+		left.children[last] = nil
+		left.children = left.children[:last]
+		n.children = slices.Insert(n.children, 0, child)
+		child.parent = n
+	}
+	p.items[i] = n.items[0]
+}
+
+// Move the first entry of right sibling to the back of child i of p
+func (t *BTree[T]) borrowRight(p *node[T], i int) {
+	n, right := p.children[i], p.children[i+1]
+	n.items = append(n.items, right.items[0])
+	right.items = slices.Delete(right.items, 0, 1)
+	if n.isLeaf() {
+		t.reindex(n, len(n.items)-1)
+		t.reindex(right, 0)
+	} else {
+		child := right.children[0]
+		right.children = slices.Delete(right.children, 0, 1)
+		n.children = append(n.children, child)
+		child.parent = n
+	}
+	p.items[i+1] = right.items[0]
+}
+
+// Move entries of child i+1 of p into child i and unlink child i+1
+func (t *BTree[T]) merge(p *node[T], i int) {
+	left, right := p.children[i], p.children[i+1]
+	start := len(left.items)
+	left.items = append(left.items, right.items...)
+	if left.isLeaf() {
+		t.reindex(left, start)
+		left.next = right.next
+		if right.next != nil {
+			right.next.prev = left
+		} else {
+			t.last = left
+		}
+	} else {
+		for _, child := range right.children {
+			child.parent = left
+		}
+		left.children = append(left.children, right.children...)
+	}
+	p.items = slices.Delete(p.items, i+1, i+2)
+	p.children = slices.Delete(p.children, i+1, i+2)
+}
+
+// Restore minimum occupancy of node after removal of its entry
+func (t *BTree[T]) rebalance(n *node[T]) {
+	if n.parent == nil {
+		if n.isLeaf() && len(n.items) == 0 {
+			t.root, t.first, t.last = nil, nil, nil
+		} else if !n.isLeaf() && len(n.children) == 1 {
+			t.root = n.children[0]
+			t.root.parent = nil
+		}
+		return
+	}
+	if len(n.items) >= minItems {
+		return
+	}
+	p := n.parent
+	i := t.childIndex(n)
+	switch {
+	case i > 0 && len(p.children[i-1].items) > minItems:
+		t.borrowLeft(p, i)
+	case i+1 < len(p.children) && len(p.children[i+1].items) > minItems:
+		t.borrowRight(p, i)
+	case i > 0:
+		t.merge(p, i-1)
+		t.rebalance(p)
+	default:
+		t.merge(p, i)
+		t.rebalance(p)
+	}
+}
+
+// Init initializes the tree to empty state.
+//
+// WARNING: Hooks of linked elements are not reset, call Clear to unlink them
+func (t *BTree[T]) Init() {
+	t.root = nil
+	t.first = nil
+	t.last = nil
+	t.size = 0
+}
+
+// Empty checks if tree has no elements
+func (t *BTree[T]) Empty() bool {
+	return t.size == 0
+}
+
+// Size returns number of elements in tree
+func (t *BTree[T]) Size() int {
+	return t.size
+}
+
+// Len returns number of elements in tree
+func (t *BTree[T]) Len() int {
+	return t.size
+}
+
+// Front returns the smallest element
+func (t *BTree[T]) Front() *T {
+	if t.first == nil {
+		return nil
+	}
+	return t.first.items[0]
+}
+
+// Back returns the largest element
+func (t *BTree[T]) Back() *T {
+	if t.last == nil {
+		return nil
+	}
+	return t.last.items[len(t.last.items)-1]
+}
+
+// Next returns the next element in order or nil if element is the last one or is not linked
+func (t *BTree[T]) Next(element *T) *T {
+	hook := t.hookFunc(element)
+	if hook.leaf == nil {
+		return nil
+	}
+	t.verifyIsMemberOfCurrent(element)
+	if hook.index+1 < len(hook.leaf.items) {
+		return hook.leaf.items[hook.index+1]
+	}
+	if hook.leaf.next != nil {
+		return hook.leaf.next.items[0]
+	}
+	return nil
+}
+
+// Prev returns the previous element in order or nil if element is the first one or is not linked
+func (t *BTree[T]) Prev(element *T) *T {
+	hook := t.hookFunc(element)
+	if hook.leaf == nil {
+		return nil
+	}
+	t.verifyIsMemberOfCurrent(element)
+	if hook.index > 0 {
+		return hook.leaf.items[hook.index-1]
+	}
+	if hook.leaf.prev != nil {
+		return hook.leaf.prev.items[len(hook.leaf.prev.items)-1]
+	}
+	return nil
+}
+
+// Insert adds element if tree has no equal element. Return true if element was inserted
+func (t *BTree[T]) Insert(item *T) bool {
+	if item == nil {
+		return false
+	}
+	t.verifyElementNotLinked(item)
+	defer t.verify()
+
+	if t.root == nil {
+		t.root = newNode[T](nil, true)
+		t.first, t.last = t.root, t.root
+	}
+	leaf := t.descend(item)
+	i := t.lower(leaf.items, item)
+	if i < len(leaf.items) && !t.lessFunc(item, leaf.items[i]) {
+		return false
+	}
+	leaf.items = slices.Insert(leaf.items, i, item)
+	t.reindex(leaf, i)
+	if i == 0 {
+		t.updateMin(leaf)
+	}
+	t.size++
+	if len(leaf.items) > maxItems {
+		t.split(leaf)
+	}
+	return true
+}
+
+// Erase removes element from tree. Return false if element is not part of tree
+func (t *BTree[T]) Erase(item *T) bool {
+	if item == nil {
+		return false
+	}
+	hook := t.hookFunc(item)
+	leaf := hook.leaf
+	if leaf == nil || hook.index >= len(leaf.items) || leaf.items[hook.index] != item || !t.owns(leaf) {
+		return false
+	}
+	defer t.verify()
+
+	i := hook.index
+	leaf.items = slices.Delete(leaf.items, i, i+1)
+	t.reindex(leaf, i)
+	hook.Init()
+	t.size--
+	if i == 0 && len(leaf.items) != 0 {
+		t.updateMin(leaf)
+	}
+	t.rebalance(leaf)
+	return true
+}
+
+// Check if node belongs to current tree by walking up to its root.
+// Complexity is logarithmic in size of tree
+func (t *BTree[T]) owns(n *node[T]) bool {
+	for n.parent != nil {
+		n = n.parent
+	}
+	return n == t.root
+}
+
+// Find searches for an element that compares equal with item
+func (t *BTree[T]) Find(item *T) *T {
+	if t.root == nil {
+		return nil
+	}
+	leaf := t.descend(item)
+	if i := t.lower(leaf.items, item); i < len(leaf.items) && !t.lessFunc(item, leaf.items[i]) {
+		return leaf.items[i]
+	}
+	return nil
+}
+
+// Contains checks if tree has an element that compares equal with item
+func (t *BTree[T]) Contains(item *T) bool {
+	return t.Find(item) != nil
+}
+
+// Return element at index of leaf or the first element of the next leaf if index is past the end
+func (t *BTree[T]) at(leaf *node[T], index int) *T {
+	if index < len(leaf.items) {
+		return leaf.items[index]
+	}
+	if leaf.next != nil {
+		return leaf.next.items[0]
+	}
+	return nil
+}
+
+// LowerBound finds first element not less than item
+func (t *BTree[T]) LowerBound(item *T) *T {
+	if t.root == nil {
+		return nil
+	}
+	leaf := t.descend(item)
+	return t.at(leaf, t.lower(leaf.items, item))
+}
+
+// UpperBound finds first element greater than item
+func (t *BTree[T]) UpperBound(item *T) *T {
+	if t.root == nil {
+		return nil
+	}
+	leaf := t.descend(item)
+	return t.at(leaf, t.upper(leaf.items, item))
+}
+
+// Traverse calls f for every element in order. F MAY erase its argument
+func (t *BTree[T]) Traverse(f func(*T)) {
+	for e := t.Front(); e != nil; {
+		next := t.Next(e)
+		f(e)
+		e = next
+	}
+}
+
+// Clear removes all elements from tree and returns them in order
+func (t *BTree[T]) Clear() []*T {
+	elements := make([]*T, 0, t.size)
+	for leaf := t.first; leaf != nil; leaf = leaf.next {
+		elements = append(elements, leaf.items...)
+	}
+	for _, e := range elements {
+		t.hookFunc(e).Init()
+	}
+	t.root, t.first, t.last = nil, nil, nil
+	t.size = 0
+	return elements
+}
@@ -0,0 +1,119 @@
+package btree
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/oracle"
+)
+
+const (
+	oracleOpInsert byte = iota
+	oracleOpErase
+	oracleOpInsertRange
+	oracleOpEraseRange
+	oracleOpFind
+	oracleOpLowerBound
+	oracleOpUpperBound
+	oracleOpClear
+	oracleOpCOUNT
+)
+
+// Apply command to BTree and to its reference model
+func oracleStep(t *testing.T, items []testItem, tree *BTree[testItem], m *oracle.Set[*testItem]) func(command []byte) {
+	insert := func(item *testItem) {
+		if item.hook.leaf == nil && tree.Insert(item) != m.Insert(item) {
+			t.Fatalf("insert of %d differs from model", item.value)
+		}
+	}
+	erase := func(item *testItem) {
+		if tree.Erase(item) != m.Erase(item) {
+			t.Fatalf("erase of %d differs from model", item.value)
+		}
+	}
+	return func(command []byte) {
+		op, arg1, arg2, arg3 := command[0], int(command[1]), int(command[2]), int(command[3])
+		from := (arg1<<8 | arg2) % len(items)
+		to := min(from+arg3, len(items))
+		item := &items[from]
+		// Key between values of items so that bounds of absent keys are checked
+		key := &testItem{value: from - arg3%2}
+
+		switch op % oracleOpCOUNT {
+		case oracleOpInsert:
+			insert(item)
+
+		case oracleOpErase:
+			erase(item)
+
+		case oracleOpInsertRange:
+			for i := from; i < to; i++ {
+				insert(&items[i])
+			}
+
+		case oracleOpEraseRange:
+			for i := from; i < to; i++ {
+				erase(&items[i])
+			}
+
+		case oracleOpFind:
+			if got, want := tree.Find(key), m.Find(key); got != want {
+				t.Fatalf("Find(%d) = %v, model has %v", key.value, got, want)
+			}
+
+		case oracleOpLowerBound:
+			if got, want := tree.LowerBound(key), m.LowerBound(key); got != want {
+				t.Fatalf("LowerBound(%d) = %v, model has %v", key.value, got, want)
+			}
+
+		case oracleOpUpperBound:
+			if got, want := tree.UpperBound(key), m.UpperBound(key); got != want {
+				t.Fatalf("UpperBound(%d) = %v, model has %v", key.value, got, want)
+			}
+
+		case oracleOpClear:
+			if diff := oracle.Diff(tree.Clear(), m.Clear()); diff != "" {
+				t.Fatalf("removed elements differ from model: %s", diff)
+			}
+		}
+	}
+}
+
+// Compare full contents of BTree with its reference model in both directions
+func oracleCheck(t *testing.T, tree *BTree[testItem], m *oracle.Set[*testItem]) func(step int) {
+	return func(step int) {
+		want := m.Elements()
+		forward := oracle.Collect(tree.Front(), tree.Next)
+		if diff := oracle.Diff(forward, want); diff != "" {
+			t.Fatalf("step %d: tree differs from model: %s", step, diff)
+		}
+		backward := oracle.Collect(tree.Back(), tree.Prev)
+		slices.Reverse(backward)
+		if diff := oracle.Diff(backward, want); diff != "" {
+			t.Fatalf("step %d: tree in reverse differs from model: %s", step, diff)
+		}
+		if tree.Len() != len(want) {
+			t.Fatalf("step %d: tree has size %d while model has %d", step, tree.Len(), len(want))
+		}
+	}
+}
+
+func FuzzBTreeOracle(f *testing.F) {
+	const numItems = 2048
+
+	for _, seed := range oracle.Seeds(32, 512) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, commands []byte) {
+		// Pairs of items with equal values check rejection of duplicates
+		items := make([]testItem, numItems)
+		for i := range items {
+			items[i] = testItem{hook: NewHook[testItem](), value: 2 * (i / 2)}
+		}
+		tree := New(testHook, lessTest)
+		m := oracle.NewSet(lessTest)
+
+		oracle.Replay(commands, 4, oracleStep(t, items, &tree, &m), oracleCheck(t, &tree, &m))
+	})
+}
@@ -0,0 +1,266 @@
+package btree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+type testItem struct {
+	hook  Hook[testItem]
+	value int
+}
+
+func testHook(self *testItem) *Hook[testItem] {
+	return &self.hook
+}
+
+func lessTest(lhs, rhs *testItem) bool {
+	return lhs.value < rhs.value
+}
+
+func newTestItems(count int) []testItem {
+	items := make([]testItem, count)
+	for i := range items {
+		items[i] = testItem{hook: NewHook[testItem](), value: i}
+	}
+	return items
+}
+
+func seq(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func treeValues(tree *BTree[testItem]) (values []int) {
+	tree.Traverse(func(e *testItem) { values = append(values, e.value) })
+	return
+}
+
+/// BTree
+
+func TestBTreeInsert(t *testing.T) {
+	tests := map[string]struct {
+		count int
+		order func(n int) []int
+	}{
+		"single":     {1, func(n int) []int { return []int{0} }},
+		"ascending":  {1000, func(n int) []int { return seq(n) }},
+		"descending": {1000, func(n int) []int { s := seq(n); slices.Reverse(s); return s }},
+		"random":     {5000, func(n int) []int { return rand.New(rand.NewPCG(1, 2)).Perm(n) }},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			items := newTestItems(tt.count)
+			tree := New(testHook, lessTest)
+			for _, i := range tt.order(tt.count) {
+				if !tree.Insert(&items[i]) {
+					t.Fatalf("element %d not inserted", i)
+				}
+			}
+			if got := treeValues(&tree); !slices.Equal(got, seq(tt.count)) {
+				t.Errorf("tree is not ordered")
+			}
+			if tree.Len() != tt.count || tree.Size() != tt.count || tree.Empty() {
+				t.Errorf("tree has size %d, want %d", tree.Len(), tt.count)
+			}
+			if tree.Front() != &items[0] || tree.Back() != &items[tt.count-1] {
+				t.Errorf("front %v and back %v", tree.Front(), tree.Back())
+			}
+		})
+	}
+}
+
+func TestBTreeInsertDuplicate(t *testing.T) {
+	items := newTestItems(100)
+	tree := New(testHook, lessTest)
+	for i := range items {
+		tree.Insert(&items[i])
+	}
+	other := testItem{value: 50}
+	if tree.Insert(&other) || other.hook != NewHook[testItem]() || tree.Insert(nil) || tree.Len() != 100 {
+		t.Errorf("duplicate or nil element inserted")
+	}
+}
+
+func TestBTreeErase(t *testing.T) {
+	tests := map[string]struct {
+		order func(n int) []int
+	}{
+		"ascending":  {func(n int) []int { return seq(n) }},
+		"descending": {func(n int) []int { s := seq(n); slices.Reverse(s); return s }},
+		"random":     {func(n int) []int { return rand.New(rand.NewPCG(3, 4)).Perm(n) }},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			const count = 3000
+			items := newTestItems(count)
+			tree := New(testHook, lessTest)
+			for i := range items {
+				tree.Insert(&items[i])
+			}
+			for k, i := range tt.order(count) {
+				if !tree.Erase(&items[i]) || tree.Erase(&items[i]) {
+					t.Fatalf("erase of %d returned wrong result", i)
+				}
+				if items[i].hook != NewHook[testItem]() || tree.Contains(&items[i]) {
+					t.Fatalf("element %d is still linked", i)
+				}
+				if tree.Len() != count-k-1 {
+					t.Fatalf("tree has size %d after %d erasures", tree.Len(), k+1)
+				}
+			}
+			if !tree.Empty() || tree.Front() != nil || tree.Back() != nil {
+				t.Errorf("tree is not empty")
+			}
+		})
+	}
+	tree := New(testHook, lessTest)
+	foreign := testItem{value: 1}
+	if tree.Erase(&foreign) || tree.Erase(nil) {
+		t.Errorf("foreign element erased")
+	}
+}
+
+func TestBTreeEraseForeign(t *testing.T) {
+	for _, count := range []int{3, 1000} {
+		lhsItems, rhsItems := newTestItems(count), newTestItems(count)
+		lhs, rhs := New(testHook, lessTest), New(testHook, lessTest)
+		for i := range lhsItems {
+			lhs.Insert(&lhsItems[i])
+			rhs.Insert(&rhsItems[i])
+		}
+		for i := range rhsItems {
+			if lhs.Erase(&rhsItems[i]) || rhs.Erase(&lhsItems[i]) {
+				t.Fatalf("element %d of other tree of size %d erased", i, count)
+			}
+		}
+		if !slices.Equal(treeValues(&lhs), seq(count)) || !slices.Equal(treeValues(&rhs), seq(count)) {
+			t.Errorf("trees of size %d changed by erase of foreign elements", count)
+		}
+		if lhs.Len() != count || rhs.Len() != count {
+			t.Errorf("trees have sizes %d and %d instead of %d", lhs.Len(), rhs.Len(), count)
+		}
+	}
+}
+
+func TestBTreeFindBounds(t *testing.T) {
+	items := newTestItems(500)
+	tree := New(testHook, lessTest)
+	for i := range items {
+		if i%2 == 0 {
+			tree.Insert(&items[i])
+		}
+	}
+	tests := map[string]struct {
+		value        int
+		find         int
+		lower, upper int
+	}{
+		"present":   {100, 100, 100, 102},
+		"absent":    {101, -1, 102, 102},
+		"first":     {0, 0, 0, 2},
+		"before":    {-5, -1, 0, 0},
+		"last":      {498, 498, 498, -1},
+		"after":     {499, -1, -1, -1},
+		"leaf edge": {63, -1, 64, 64},
+	}
+	value := func(e *testItem) int {
+		if e == nil {
+			return -1
+		}
+		return e.value
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			key := &testItem{value: tt.value}
+			if got := value(tree.Find(key)); got != tt.find || tree.Contains(key) != (tt.find >= 0) {
+				t.Errorf("Find(%d) = %d, want %d", tt.value, got, tt.find)
+			}
+			if got := value(tree.LowerBound(key)); got != tt.lower {
+				t.Errorf("LowerBound(%d) = %d, want %d", tt.value, got, tt.lower)
+			}
+			if got := value(tree.UpperBound(key)); got != tt.upper {
+				t.Errorf("UpperBound(%d) = %d, want %d", tt.value, got, tt.upper)
+			}
+		})
+	}
+	empty := New(testHook, lessTest)
+	if empty.Find(&items[0]) != nil || empty.LowerBound(&items[0]) != nil || empty.UpperBound(&items[0]) != nil {
+		t.Errorf("empty tree has elements")
+	}
+}
+
+func TestBTreeNextPrev(t *testing.T) {
+	items := newTestItems(300)
+	tree := New(testHook, lessTest)
+	for _, i := range rand.New(rand.NewPCG(5, 6)).Perm(len(items)) {
+		tree.Insert(&items[i])
+	}
+	var forward, backward []int
+	for e := tree.Front(); e != nil; e = tree.Next(e) {
+		forward = append(forward, e.value)
+	}
+	for e := tree.Back(); e != nil; e = tree.Prev(e) {
+		backward = append(backward, e.value)
+	}
+	slices.Reverse(backward)
+	if !slices.Equal(forward, seq(len(items))) || !slices.Equal(backward, seq(len(items))) {
+		t.Errorf("forward and backward iteration differ from order")
+	}
+	unlinked := testItem{value: 1}
+	if tree.Next(&unlinked) != nil || tree.Prev(&unlinked) != nil {
+		t.Errorf("unlinked element has neighbours")
+	}
+}
+
+func TestBTreeTraverseErase(t *testing.T) {
+	items := newTestItems(1000)
+	tree := New(testHook, lessTest)
+	for i := range items {
+		tree.Insert(&items[i])
+	}
+	tree.Traverse(func(e *testItem) {
+		if e.value%3 != 0 {
+			tree.Erase(e)
+		}
+	})
+	var want []int
+	for i := 0; i < len(items); i += 3 {
+		want = append(want, i)
+	}
+	if got := treeValues(&tree); !slices.Equal(got, want) {
+		t.Errorf("tree has %d elements after erase in traverse, want %d", len(got), len(want))
+	}
+	removed := tree.Clear()
+	if len(removed) != len(want) || !tree.Empty() || tree.Front() != nil {
+		t.Errorf("clear returned %d elements", len(removed))
+	}
+	for i, e := range removed {
+		if e.value != want[i] || e.hook != NewHook[testItem]() {
+			t.Errorf("clear returned element %d with hook %v", e.value, e.hook)
+		}
+	}
+}
+
+func TestBTreeInit(t *testing.T) {
+	items := newTestItems(100)
+	tree := New(testHook, lessTest)
+	for i := range items {
+		tree.Insert(&items[i])
+	}
+	tree.Init()
+	if !tree.Empty() || tree.Len() != 0 || tree.Front() != nil || tree.Back() != nil {
+		t.Errorf("tree is not empty after Init")
+	}
+	if err := tree.Validate(); err != nil {
+		t.Errorf("tree is invalid after Init: %v", err)
+	}
+	item := testItem{hook: NewHook[testItem](), value: 0}
+	if !tree.Insert(&item) || tree.Front() != &item {
+		t.Errorf("tree is not usable after Init")
+	}
+}
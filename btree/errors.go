@@ -0,0 +1,46 @@
+package btree
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrSizeMismatch is returned by Validate when number of linked elements is not equal to the size of BTree
+	ErrSizeMismatch = errors.New("btree: size mismatch")
+	// ErrParentPointer is returned by Validate when parent pointer of a node does not point to its parent
+	ErrParentPointer = errors.New("btree: parent pointer mismatch")
+	// ErrOccupancy is returned by Validate when node other than the root holds too few or too many entries
+	ErrOccupancy = errors.New("btree: node occupancy violation")
+	// ErrDepth is returned by Validate when leaves are not on the same depth or internal node has too few children
+	ErrDepth = errors.New("btree: depth mismatch")
+	// ErrOrder is returned by Validate when elements are not ordered according to lessFunc
+	ErrOrder = errors.New("btree: order violation")
+	// ErrHookMismatch is returned by Validate when Hook of an element does not point to its position in a leaf
+	ErrHookMismatch = errors.New("btree: hook mismatch")
+	// ErrBrokenLink is returned by Validate when leaves are not linked in order or first or last leaf is wrong
+	ErrBrokenLink = errors.New("btree: broken link")
+)
+
+type (
+	// ValidationError is returned by Validate and holds the offending element.
+	// For violations of a node Element is the smallest element of that node.
+	// Element may be nil if error is not related to a particular element
+	ValidationError[T any] struct {
+		Err     error
+		Element *T
+	}
+)
+
+// Error returns error description with address of the offending element
+func (e *ValidationError[T]) Error() string {
+	if e.Element == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v: element: %p", e.Err, e.Element)
+}
+
+// Unwrap returns underlying sentinel error
+func (e *ValidationError[T]) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,104 @@
+package btree
+
+// Validate checks integrity of the tree and returns *ValidationError describing
+// the first detected violation or nil if the tree is consistent.
+// Unlike debug verifiers it is available in all builds.
+//
+// Complexity is linear in size of the tree
+func (t *BTree[T]) Validate() error {
+	v := validator[T]{tree: t}
+	if t.root != nil {
+		if t.root.parent != nil {
+			return &ValidationError[T]{Err: ErrParentPointer, Element: first(t.root)}
+		}
+		if len(t.root.items) == 0 {
+			return &ValidationError[T]{Err: ErrOccupancy, Element: nil}
+		}
+		if _, err := v.validate(t.root); err != nil {
+			return err
+		}
+	}
+	if t.first != v.first || t.last != v.prev {
+		return &ValidationError[T]{Err: ErrBrokenLink, Element: nil}
+	}
+	if t.last != nil && t.last.next != nil {
+		return &ValidationError[T]{Err: ErrBrokenLink, Element: t.last.items[len(t.last.items)-1]}
+	}
+	if v.count != t.size {
+		return &ValidationError[T]{Err: ErrSizeMismatch, Element: nil}
+	}
+	return nil
+}
+
+// validator holds state of in-order validation traversal
+type validator[T any] struct {
+	tree        *BTree[T]
+	first, prev *node[T]
+	count       int
+}
+
+// first returns the smallest element of a node or nil if node is empty
+func first[T any](n *node[T]) *T {
+	if len(n.items) == 0 {
+		return nil
+	}
+	return n.items[0]
+}
+
+// validate checks subtree of n in-order and returns its depth.
+// As parent pointers of every child are checked there can be no cycles in checked subtree
+func (v *validator[T]) validate(n *node[T]) (int, error) {
+	t := v.tree
+	if n.parent != nil && (len(n.items) < minItems || len(n.items) > maxItems) {
+		return 0, &ValidationError[T]{Err: ErrOccupancy, Element: first(n)}
+	}
+	for i := 1; i < len(n.items); i++ {
+		if !t.lessFunc(n.items[i-1], n.items[i]) {
+			return 0, &ValidationError[T]{Err: ErrOrder, Element: n.items[i]}
+		}
+	}
+	if n.isLeaf() {
+		if n.prev != v.prev {
+			return 0, &ValidationError[T]{Err: ErrBrokenLink, Element: first(n)}
+		}
+		if v.prev != nil {
+			if v.prev.next != n {
+				return 0, &ValidationError[T]{Err: ErrBrokenLink, Element: first(n)}
+			}
+			if !t.lessFunc(v.prev.items[len(v.prev.items)-1], n.items[0]) {
+				return 0, &ValidationError[T]{Err: ErrOrder, Element: first(n)}
+			}
+		} else {
+			v.first = n
+		}
+		for i, e := range n.items {
+			if hook := t.hookFunc(e); hook.leaf != n || hook.index != i {
+				return 0, &ValidationError[T]{Err: ErrHookMismatch, Element: e}
+			}
+		}
+		v.prev = n
+		v.count += len(n.items)
+		return 1, nil
+	}
+	if len(n.children) != len(n.items) || len(n.children) < 2 {
+		return 0, &ValidationError[T]{Err: ErrDepth, Element: first(n)}
+	}
+	depth := 0
+	for i, child := range n.children {
+		if child.parent != n {
+			return 0, &ValidationError[T]{Err: ErrParentPointer, Element: first(child)}
+		}
+		if first(child) != n.items[i] {
+			return 0, &ValidationError[T]{Err: ErrOrder, Element: n.items[i]}
+		}
+		d, err := v.validate(child)
+		if err != nil {
+			return 0, err
+		}
+		if depth != 0 && d != depth {
+			return 0, &ValidationError[T]{Err: ErrDepth, Element: first(child)}
+		}
+		depth = d
+	}
+	return depth + 1, nil
+}
@@ -0,0 +1,87 @@
+package btree
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+func newValidateTree(items []testItem) *BTree[testItem] {
+	tree := New(testHook, lessTest)
+	for _, i := range rand.New(rand.NewPCG(3, 4)).Perm(len(items)) {
+		tree.Insert(&items[i])
+	}
+	return &tree
+}
+
+func TestBTreeValidateValidTree(t *testing.T) {
+	for _, size := range []int{0, 1, maxItems, maxItems + 1, 1000} {
+		tree := newValidateTree(newTestItems(size))
+		if err := tree.Validate(); err != nil {
+			t.Errorf("tree of size %v is invalid: %v", size, err)
+		}
+	}
+}
+
+func TestBTreeValidateDetectsCorruption(t *testing.T) {
+	tests := map[string]struct {
+		corrupt func(tree *BTree[testItem]) *testItem
+		err     error
+	}{
+		"root-parent": {func(tree *BTree[testItem]) *testItem {
+			tree.root.parent = tree.first
+			return tree.root.items[0]
+		}, ErrParentPointer},
+		"child-parent": {func(tree *BTree[testItem]) *testItem {
+			tree.last.parent = tree.last
+			return tree.last.items[0]
+		}, ErrParentPointer},
+		"occupancy": {func(tree *BTree[testItem]) *testItem {
+			leaf := tree.last
+			leaf.items = leaf.items[:1]
+			return leaf.items[0]
+		}, ErrOccupancy},
+		"order": {func(tree *BTree[testItem]) *testItem {
+			leaf := tree.first
+			leaf.items[1].value = -1
+			return leaf.items[1]
+		}, ErrOrder},
+		"hook": {func(tree *BTree[testItem]) *testItem {
+			e := tree.first.items[2]
+			testHook(e).index++
+			return e
+		}, ErrHookMismatch},
+		"leaf-link": {func(tree *BTree[testItem]) *testItem {
+			tree.first.next = tree.last
+			return nil
+		}, ErrBrokenLink},
+		"first": {func(tree *BTree[testItem]) *testItem {
+			tree.first = tree.last
+			return nil
+		}, ErrBrokenLink},
+		"size": {func(tree *BTree[testItem]) *testItem {
+			tree.size++
+			return nil
+		}, ErrSizeMismatch},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			tree := newValidateTree(newTestItems(1000))
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("tree is invalid before corruption: %v", err)
+			}
+			element := testCase.corrupt(tree)
+			err := tree.Validate()
+			if !errors.Is(err, testCase.err) {
+				t.Fatalf("expected %v got %v", testCase.err, err)
+			}
+			var validationErr *ValidationError[testItem]
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("error is not ValidationError %v", err)
+			}
+			if element != nil && validationErr.Element != element {
+				t.Errorf("unexpected offending element %p instead of %p", validationErr.Element, element)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+//go:build debug
+
+package btree
+
+import (
+	"fmt"
+)
+
+func (t *BTree[T]) verifyElementNotLinked(element *T) {
+	if t.hookFunc(element).leaf != nil {
+		panic(fmt.Sprintf("already linked element detected: BTree %p element: %p", t, element))
+	}
+}
+
+func (t *BTree[T]) verifyIsMemberOfCurrent(element *T) {
+	n := t.hookFunc(element).leaf
+	for n.parent != nil {
+		n = n.parent
+	}
+	if n != t.root {
+		panic(fmt.Sprintf("not member of detected: BTree %p element: %p", t, element))
+	}
+}
+
+// Validate structure of the tree and panic on first violation
+func (t *BTree[T]) verify() {
+	if err := t.Validate(); err != nil {
+		panic(fmt.Sprintf("%v: BTree %p", err, t))
+	}
+}
@@ -0,0 +1,12 @@
+//go:build !debug
+
+package btree
+
+func (t *BTree[T]) verifyElementNotLinked(element *T) {
+}
+
+func (t *BTree[T]) verifyIsMemberOfCurrent(element *T) {
+}
+
+func (t *BTree[T]) verify() {
+}
@@ -0,0 +1,60 @@
+// Package debugdump writes structure of intrusive containers in Graphviz DOT
+// format and as plain text diagrams to visualize failures of integrity checks.
+//
+// Every function takes label that returns text describing an element, nil label
+// prints element addresses. Dumps follow links as they are stored in hooks without
+// running debug verifiers of containers and stop at cycles, so broken containers
+// can be dumped as well:
+//
+//	defer func() {
+//		if r := recover(); r != nil {
+//			debugdump.RbTreeDOT(os.Stderr, tree, func(e *Item) string { return strconv.Itoa(e.key) })
+//			panic(r)
+//		}
+//	}()
+//
+// Output of DOT functions is rendered with `dot -Tsvg`
+package debugdump
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Writer that remembers the first error and ignores all writes after it
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...any) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+// Numbering of elements in order of their discovery
+type ids[T any] map[*T]int
+
+// Return id of element and true if element was seen before
+func (m ids[T]) get(e *T) (id int, seen bool) {
+	if id, seen = m[e]; !seen {
+		id = len(m)
+		m[e] = id
+	}
+	return
+}
+
+// Return label function that prints element addresses if label is nil
+func labelOrAddress[T any](label func(*T) string) func(*T) string {
+	if label != nil {
+		return label
+	}
+	return func(e *T) string { return fmt.Sprintf("%p", e) }
+}
+
+// Quote text to be used as DOT string
+func quote(s string) string {
+	return strconv.Quote(s)
+}
@@ -0,0 +1,171 @@
+package debugdump
+
+import (
+	"io"
+
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+// Write chain of SList elements in Graphviz DOT format.
+//
+// Link that closes a cycle is drawn in orange. Front and Back are marked
+// by front and back labels
+func SListDOT[T any](w io.Writer, l *slist.SList[T], label func(*T) string) error {
+	return listDOT(w, "SList", l.Len(), l.Front(), l.Back(), slistNext(l), nil, label)
+}
+
+// Write chain of DList elements in Graphviz DOT format.
+//
+// Next links are solid and prev links are dashed. Prev links that do not
+// point to the previous element and links that close a cycle are drawn in orange.
+// Front and Back are marked by front and back labels
+func DListDOT[T any](w io.Writer, l *dlist.DList[T], label func(*T) string) error {
+	return listDOT(w, "DList", l.Len(), l.Front(), l.Back(), dlistNext(l), dlistPrev(l), label)
+}
+
+// Read links from hooks directly as Next and Prev of lists run verifiers in debug builds
+func slistNext[T any](l *slist.SList[T]) func(*T) *T {
+	return func(e *T) *T { return l.Hook(e).Next() }
+}
+
+func dlistNext[T any](l *dlist.DList[T]) func(*T) *T {
+	return func(e *T) *T { return l.Hook(e).Next() }
+}
+
+func dlistPrev[T any](l *dlist.DList[T]) func(*T) *T {
+	return func(e *T) *T { return l.Hook(e).Prev() }
+}
+
+func listDOT[T any](w io.Writer, name string, size int, front, back *T, next, prev func(*T) *T, label func(*T) string) error {
+	p := &printer{w: w}
+	label = labelOrAddress(label)
+	nodes := make(ids[T])
+
+	p.printf("digraph %s {\n", name)
+	p.printf("\trankdir=LR;\n")
+	p.printf("\tnode [shape=box];\n")
+	p.printf("\tsize [shape=plaintext, label=\"size: %d\"];\n", size)
+	p.printf("\tnil [shape=point];\n")
+
+	var previous *T
+	for e := front; e != nil; {
+		id, _ := nodes.get(e)
+		p.printf("\tn%d [label=%s];\n", id, quote(label(e)))
+		if prev != nil {
+			if actual := prev(e); actual == nil {
+				if previous != nil {
+					p.printf("\tn%d -> nil [style=dashed, color=orange, constraint=false];\n", id)
+				}
+			} else {
+				actualId, _ := nodes.get(actual)
+				color := "gray"
+				if actual != previous {
+					color = "orange"
+				}
+				p.printf("\tn%d -> n%d [style=dashed, color=%s, constraint=false];\n", id, actualId, color)
+			}
+		}
+
+		n := next(e)
+		if n == nil {
+			p.printf("\tn%d -> nil;\n", id)
+			break
+		}
+		nextId, seen := nodes.get(n)
+		if seen {
+			p.printf("\tn%d -> n%d [color=orange];\n", id, nextId)
+			break
+		}
+		p.printf("\tn%d -> n%d;\n", id, nextId)
+		previous, e = e, n
+	}
+
+	for _, marker := range [...]struct {
+		node *T
+		name string
+	}{{front, "front"}, {back, "back"}} {
+		if marker.node == nil {
+			continue
+		}
+		id, _ := nodes.get(marker.node)
+		p.printf("\t%s [shape=plaintext];\n", marker.name)
+		p.printf("\t%s -> n%d [style=dotted];\n", marker.name, id)
+	}
+
+	p.printf("}\n")
+	return p.err
+}
+
+// Write chain of SList elements as single line text diagram:
+//
+//	SList size: 3: [1] -> [2] -> [3] -> nil
+//
+// Link that closes a cycle is marked by "!cycle" and Back that is not the last
+// element of the chain is printed after the chain
+func SListASCII[T any](w io.Writer, l *slist.SList[T], label func(*T) string) error {
+	return listASCII(w, "SList", l.Len(), l.Front(), l.Back(), slistNext(l), nil, label)
+}
+
+// Write chain of DList elements as single line text diagram:
+//
+//	DList size: 3: nil <- [1] <-> [2] <-> [3] -> nil
+//
+// Elements which prev link does not point to the previous element are followed
+// by "{prev: label}", link that closes a cycle is marked by "!cycle" and Back
+// that is not the last element of the chain is printed after the chain
+func DListASCII[T any](w io.Writer, l *dlist.DList[T], label func(*T) string) error {
+	return listASCII(w, "DList", l.Len(), l.Front(), l.Back(), dlistNext(l), dlistPrev(l), label)
+}
+
+func listASCII[T any](w io.Writer, name string, size int, front, back *T, next, prev func(*T) *T, label func(*T) string) error {
+	p := &printer{w: w}
+	label = labelOrAddress(label)
+	nodes := make(ids[T])
+
+	p.printf("%s size: %d:", name, size)
+	if prev != nil && front != nil {
+		p.printf(" nil <-")
+	}
+
+	var previous, last *T
+	for e := front; e != nil; e = next(e) {
+		if _, seen := nodes.get(e); seen {
+			p.printf(" [%s] !cycle", label(e))
+			break
+		}
+		if previous != nil {
+			if prev != nil && prev(e) == previous {
+				p.printf(" <->")
+			} else {
+				p.printf(" ->")
+			}
+		}
+		p.printf(" [%s]", label(e))
+		if prev != nil {
+			if actual := prev(e); actual != previous {
+				if actual == nil {
+					p.printf("{prev: nil}")
+				} else {
+					p.printf("{prev: %s}", label(actual))
+				}
+			}
+		}
+		previous, last = e, e
+		if next(e) == nil {
+			p.printf(" -> nil")
+		}
+	}
+	if front == nil {
+		p.printf(" nil")
+	}
+	if back != last {
+		if back == nil {
+			p.printf(" back: nil")
+		} else {
+			p.printf(" back: [%s]", label(back))
+		}
+	}
+	p.printf("\n")
+	return p.err
+}
@@ -0,0 +1,144 @@
+package debugdump
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+type testListItem struct {
+	sHook slist.Hook[testListItem]
+	dHook dlist.Hook[testListItem]
+	value int
+}
+
+func sHook(self *testListItem) *slist.Hook[testListItem] {
+	return &self.sHook
+}
+
+func dHook(self *testListItem) *dlist.Hook[testListItem] {
+	return &self.dHook
+}
+
+func listLabel(e *testListItem) string {
+	return strconv.Itoa(e.value)
+}
+
+func newLists(values ...int) (slist.SList[testListItem], dlist.DList[testListItem], []testListItem) {
+	s, d := slist.New(sHook), dlist.New(dHook)
+	items := make([]testListItem, len(values))
+	for i, v := range values {
+		items[i].value = v
+		s.PushBack(&items[i])
+		d.PushBack(&items[i])
+	}
+	return s, d, items
+}
+
+// Call f that damages container. Verifiers of debug builds panic after damage is done
+func damage(f func()) {
+	defer func() { _ = recover() }()
+	f()
+}
+
+func TestListASCII(t *testing.T) {
+	tests := map[string]struct {
+		values       []int
+		slist, dlist string
+	}{
+		"empty":  {[]int{}, "SList size: 0: nil\n", "DList size: 0: nil\n"},
+		"single": {[]int{1}, "SList size: 1: [1] -> nil\n", "DList size: 1: nil <- [1] -> nil\n"},
+		"many":   {[]int{1, 2, 3}, "SList size: 3: [1] -> [2] -> [3] -> nil\n", "DList size: 3: nil <- [1] <-> [2] <-> [3] -> nil\n"},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, d, _ := newLists(testCase.values...)
+			var b strings.Builder
+			if err := SListASCII(&b, &s, listLabel); err != nil || b.String() != testCase.slist {
+				t.Errorf("slist dump is %q, error %v", b.String(), err)
+			}
+			b.Reset()
+			if err := DListASCII(&b, &d, listLabel); err != nil || b.String() != testCase.dlist {
+				t.Errorf("dlist dump is %q, error %v", b.String(), err)
+			}
+		})
+	}
+}
+
+func TestListASCIIBrokenLinks(t *testing.T) {
+	_, d, items := newLists(1, 2, 3)
+	items[2].dHook = dlist.NewHook[testListItem]()
+	var b strings.Builder
+	if err := DListASCII(&b, &d, listLabel); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	if want := "DList size: 3: nil <- [1] <-> [2] -> [3]{prev: nil} -> nil\n"; b.String() != want {
+		t.Errorf("dlist dump is %q instead of %q", b.String(), want)
+	}
+}
+
+func TestListASCIICycle(t *testing.T) {
+	s, d, items := newLists(1, 2, 3)
+	// Relink last element to the front while element before it still points to it
+	items[2].sHook, items[2].dHook = slist.NewHook[testListItem](), dlist.NewHook[testListItem]()
+	damage(func() { s.PushFront(&items[2]) })
+	damage(func() { d.PushFront(&items[2]) })
+	var b strings.Builder
+	if err := SListASCII(&b, &s, listLabel); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	if want := "SList size: 4: [3] -> [1] -> [2] [3] !cycle back: [3]\n"; b.String() != want {
+		t.Errorf("slist dump is %q instead of %q", b.String(), want)
+	}
+	b.Reset()
+	if err := DListASCII(&b, &d, listLabel); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	if want := "DList size: 4: nil <- [3] <-> [1] <-> [2] [3] !cycle back: [3]\n"; b.String() != want {
+		t.Errorf("dlist dump is %q instead of %q", b.String(), want)
+	}
+}
+
+func TestListDOT(t *testing.T) {
+	s, d, _ := newLists(1, 2)
+	var b strings.Builder
+	if err := SListDOT(&b, &s, listLabel); err != nil {
+		t.Fatalf("slist dump failed: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{"digraph SList {", `n0 [label="1"];`, "n0 -> n1;", "n1 -> nil;", "front -> n0", "back -> n1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("slist dump does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "dashed") {
+		t.Errorf("slist dump contains prev links:\n%s", out)
+	}
+
+	b.Reset()
+	if err := DListDOT(&b, &d, nil); err != nil {
+		t.Fatalf("dlist dump failed: %v", err)
+	}
+	out = b.String()
+	for _, want := range []string{"digraph DList {", "n1 -> n0 [style=dashed, color=gray, constraint=false];", `label="size: 2"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dlist dump does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "orange") {
+		t.Errorf("dump of valid dlist marks broken links:\n%s", out)
+	}
+}
+
+func TestListDOTWriteError(t *testing.T) {
+	s, d, _ := newLists(1)
+	if err := SListDOT(failingWriter{}, &s, nil); err != errWrite {
+		t.Errorf("slist dump returned %v instead of write error", err)
+	}
+	if err := DListASCII(failingWriter{}, &d, nil); err != errWrite {
+		t.Errorf("dlist dump returned %v instead of write error", err)
+	}
+}
@@ -0,0 +1,137 @@
+package debugdump
+
+import (
+	"io"
+
+	"github.com/echo-Mike/intrusive/rbtree"
+)
+
+// Write RbTree in Graphviz DOT format.
+//
+// Nodes are filled with their colors, child edges are solid and parent pointers
+// are dashed. Parent pointers that do not point to the actual parent and child
+// links that close a cycle are drawn in orange. Front and Back are marked
+// by first and last labels
+func RbTreeDOT[T any](w io.Writer, t *rbtree.RbTree[T], label func(*T) string) error {
+	p := &printer{w: w}
+	label = labelOrAddress(label)
+	nodes := make(ids[T])
+
+	p.printf("digraph RbTree {\n")
+	p.printf("\tnode [shape=circle, style=filled, fontcolor=white];\n")
+	p.printf("\tsize [shape=plaintext, fontcolor=black, style=\"\", label=\"size: %d\"];\n", t.Size())
+
+	var visit func(node, parent *T, id int)
+	visit = func(node, parent *T, id int) {
+		color := "black"
+		if t.IsRed(node) {
+			color = "red"
+		}
+		p.printf("\tn%d [label=%s, fillcolor=%s];\n", id, quote(label(node)), color)
+
+		if actual := t.Parent(node); actual != nil || parent != nil {
+			if actual == nil {
+				p.printf("\tnil%d [shape=point];\n", id)
+				p.printf("\tn%d -> nil%d [style=dashed, color=orange, constraint=false];\n", id, id)
+			} else {
+				parentId, _ := nodes.get(actual)
+				edgeColor := "gray"
+				if actual != parent {
+					edgeColor = "orange"
+				}
+				p.printf("\tn%d -> n%d [style=dashed, color=%s, constraint=false];\n", id, parentId, edgeColor)
+			}
+		}
+
+		for _, child := range [...]struct {
+			node *T
+			side string
+		}{{t.Left(node), "L"}, {t.Right(node), "R"}} {
+			if child.node == nil {
+				continue
+			}
+			childId, seen := nodes.get(child.node)
+			if seen {
+				p.printf("\tn%d -> n%d [label=%s, color=orange];\n", id, childId, child.side)
+				continue
+			}
+			p.printf("\tn%d -> n%d [label=%s];\n", id, childId, child.side)
+			visit(child.node, node, childId)
+		}
+	}
+	if root := t.Root(); root != nil {
+		id, _ := nodes.get(root)
+		visit(root, nil, id)
+	}
+
+	for _, marker := range [...]struct {
+		node *T
+		name string
+	}{{t.Front(), "first"}, {t.Back(), "last"}} {
+		if marker.node == nil {
+			continue
+		}
+		id, _ := nodes.get(marker.node)
+		p.printf("\t%s [shape=plaintext, fontcolor=black, style=\"\"];\n", marker.name)
+		p.printf("\t%s -> n%d [style=dotted];\n", marker.name, id)
+	}
+
+	p.printf("}\n")
+	return p.err
+}
+
+// Write RbTree as indented text diagram, one node per line:
+//
+//	4 [B]
+//	├─L 2 [R]
+//	│ ├─L 1 [B] <first
+//	│ └─R 3 [B]
+//	└─R 5 [B] >last
+//
+// Parent pointers that do not point to the actual parent are marked by "!parent"
+// and child links that close a cycle by "!cycle"
+func RbTreeASCII[T any](w io.Writer, t *rbtree.RbTree[T], label func(*T) string) error {
+	p := &printer{w: w}
+	label = labelOrAddress(label)
+	nodes := make(ids[T])
+
+	p.printf("RbTree size: %d\n", t.Size())
+	var visit func(node, parent *T, prefix, childPrefix string)
+	visit = func(node, parent *T, prefix, childPrefix string) {
+		color := "B"
+		if t.IsRed(node) {
+			color = "R"
+		}
+		p.printf("%s%s [%s]", prefix, label(node), color)
+		if node == t.Front() {
+			p.printf(" <first")
+		}
+		if node == t.Back() {
+			p.printf(" >last")
+		}
+		if t.Parent(node) != parent {
+			p.printf(" !parent")
+		}
+		if _, seen := nodes.get(node); seen {
+			p.printf(" !cycle\n")
+			return
+		}
+		p.printf("\n")
+
+		left, right := t.Left(node), t.Right(node)
+		if left != nil {
+			if right != nil {
+				visit(left, node, childPrefix+"├─L ", childPrefix+"│ ")
+			} else {
+				visit(left, node, childPrefix+"└─L ", childPrefix+"  ")
+			}
+		}
+		if right != nil {
+			visit(right, node, childPrefix+"└─R ", childPrefix+"  ")
+		}
+	}
+	if root := t.Root(); root != nil {
+		visit(root, nil, "", "")
+	}
+	return p.err
+}
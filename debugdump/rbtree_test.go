@@ -0,0 +1,132 @@
+package debugdump
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/rbtree"
+)
+
+type testTreeItem struct {
+	hook  rbtree.Hook[testTreeItem]
+	value int
+}
+
+func treeHook(self *testTreeItem) *rbtree.Hook[testTreeItem] {
+	return &self.hook
+}
+
+func treeLess(lhs, rhs *testTreeItem) bool {
+	return lhs.value < rhs.value
+}
+
+func treeLabel(e *testTreeItem) string {
+	return strconv.Itoa(e.value)
+}
+
+func newTree(values ...int) (*rbtree.RbTree[testTreeItem], []testTreeItem) {
+	t := rbtree.New(treeHook, treeLess)
+	items := make([]testTreeItem, len(values))
+	for i, v := range values {
+		items[i] = testTreeItem{hook: rbtree.NewHook[testTreeItem](), value: v}
+		t.Insert(&items[i])
+	}
+	return &t, items
+}
+
+type failingWriter struct{}
+
+var errWrite = errors.New("write failed")
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestRbTreeDOT(t *testing.T) {
+	tree, _ := newTree(2, 1, 3)
+	var b strings.Builder
+	if err := RbTreeDOT(&b, tree, treeLabel); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"digraph RbTree {",
+		`n0 [label="2", fillcolor=black];`,
+		`n1 [label="1", fillcolor=red];`,
+		`n2 [label="3", fillcolor=red];`,
+		"n0 -> n1 [label=L];",
+		"n0 -> n2 [label=R];",
+		"n1 -> n0 [style=dashed, color=gray, constraint=false];",
+		"first -> n1 [style=dotted];",
+		"last -> n2 [style=dotted];",
+		`label="size: 3"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dump does not contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "orange") {
+		t.Errorf("dump of valid tree marks broken links:\n%s", out)
+	}
+}
+
+func TestRbTreeDOTEmpty(t *testing.T) {
+	tree, _ := newTree()
+	var b strings.Builder
+	if err := RbTreeDOT(&b, tree, nil); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	if out := b.String(); strings.Contains(out, "first") || !strings.HasSuffix(out, "}\n") {
+		t.Errorf("dump of empty tree is incorrect:\n%s", out)
+	}
+}
+
+func TestRbTreeDOTBrokenParent(t *testing.T) {
+	tree, items := newTree(2, 1, 3)
+	items[1].hook = rbtree.NewHook[testTreeItem]()
+	var b strings.Builder
+	if err := RbTreeDOT(&b, tree, treeLabel); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	if out := b.String(); !strings.Contains(out, "color=orange") {
+		t.Errorf("dump does not mark broken parent pointer:\n%s", out)
+	}
+}
+
+func TestRbTreeDOTWriteError(t *testing.T) {
+	tree, _ := newTree(1, 2)
+	if err := RbTreeDOT(failingWriter{}, tree, treeLabel); !errors.Is(err, errWrite) {
+		t.Errorf("dump returned %v instead of write error", err)
+	}
+}
+
+func TestRbTreeASCII(t *testing.T) {
+	tree, _ := newTree(4, 2, 5, 1, 3)
+	var b strings.Builder
+	if err := RbTreeASCII(&b, tree, treeLabel); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	want := "RbTree size: 5\n" +
+		"4 [B]\n" +
+		"├─L 2 [B]\n" +
+		"│ ├─L 1 [R] <first\n" +
+		"│ └─R 3 [R]\n" +
+		"└─R 5 [B] >last\n"
+	if out := b.String(); out != want {
+		t.Errorf("dump is\n%s\ninstead of\n%s", out, want)
+	}
+}
+
+func TestRbTreeASCIIBrokenParent(t *testing.T) {
+	tree, items := newTree(2, 1, 3)
+	items[2].hook = rbtree.NewHook[testTreeItem]()
+	var b strings.Builder
+	if err := RbTreeASCII(&b, tree, nil); err != nil {
+		t.Fatalf("dump failed: %v", err)
+	}
+	if out := b.String(); !strings.Contains(out, "!parent") {
+		t.Errorf("dump does not mark broken parent pointer:\n%s", out)
+	}
+}
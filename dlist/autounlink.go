@@ -0,0 +1,114 @@
+package dlist
+
+import (
+	"fmt"
+)
+
+type (
+	// Hook structure to insert/embed into concrete types of elements
+	// of doubly-linked list intrusive container that tracks DList it is linked into.
+	//
+	// Elements with such hooks can be removed from their DList without
+	// a reference to it by calling Unlink on their hook.
+	//
+	// This is also a safe-mode hook: DList created with NewAutoUnlink panics with
+	// ErrAlreadyLinked on insertion of linked element and with ErrNotMember when
+	// element of other container is used, in all builds
+	AutoUnlinkHook[T any] struct {
+		Hook[T]
+		owner *DList[T]
+	}
+)
+
+// Initialize hook to empty state.
+//
+// WARNING: Calling this function on linked AutoUnlinkHook will damage DList structure
+func (h *AutoUnlinkHook[T]) Init() {
+	h.Hook.Init()
+	h.owner = nil
+}
+
+// Create auto-unlink hook in empty state
+func NewAutoUnlinkHook[T any]() AutoUnlinkHook[T] {
+	return AutoUnlinkHook[T]{Hook: NewHook[T](), owner: nil}
+}
+
+// Check if element of this hook is part of some DList
+func (h AutoUnlinkHook[T]) IsLinked() bool {
+	return h.owner != nil
+}
+
+// Return DList that element of this hook is part of or nil if it is not linked
+func (h AutoUnlinkHook[T]) Owner() *DList[T] {
+	return h.owner
+}
+
+// Remove element of this hook from DList it is part of. Do nothing if hook is not linked.
+//
+// Complexity is constant as element is located using its neighbours
+func (h *AutoUnlinkHook[T]) Unlink() {
+	d := h.owner
+	if d == nil {
+		return
+	}
+	element := d.first
+	if h.prev != nil {
+		element = d.hook(h.prev).next
+	}
+	d.Erase(element)
+}
+
+// Create new DList container of elements with AutoUnlinkHook.
+//
+// Each linked element stores the address of the DList head, so the head
+// MUST NOT be copied or moved while it is not empty. Operations that move elements
+// between heads (Swap, Splice, SpliceRange, Merge) update owner of every moved
+// element and as a result have linear complexity in number of moved elements
+func NewAutoUnlink[T any](hookFunc func(*T) *AutoUnlinkHook[T]) DList[T] {
+	return DList[T]{
+		hookFunc:  func(e *T) *Hook[T] { return &hookFunc(e).Hook },
+		ownerFunc: func(e *T) **DList[T] { return &hookFunc(e).owner },
+		size:      0,
+		first:     nil,
+		last:      nil,
+	}
+}
+
+// Set owner of elements in range [first, last] if current DList tracks owners
+func (d *DList[T]) setOwner(first, last *T, owner *DList[T]) {
+	if d.ownerFunc == nil || first == nil {
+		return
+	}
+	for e := first; ; e = d.hook(e).next {
+		*d.ownerFunc(e) = owner
+		if e == last {
+			return
+		}
+	}
+}
+
+// Panic with ErrAlreadyLinked if current DList tracks owners and element is linked
+func (d *DList[T]) checkNotLinked(element *T) {
+	if d.ownerFunc != nil && *d.ownerFunc(element) != nil {
+		panic(fmt.Errorf("%w: DList %p element: %p", ErrAlreadyLinked, d, element))
+	}
+}
+
+// Panic with ErrNotMember if current DList tracks owners and element is not its member
+func (d *DList[T]) checkIsMember(element *T) {
+	if d.ownerFunc != nil && *d.ownerFunc(element) != d {
+		panic(fmt.Errorf("%w: DList %p element: %p", ErrNotMember, d, element))
+	}
+}
+
+// Panic with ErrModeMismatch if only one of current and other DList tracks owners
+// and with ErrNotMember if boundary elements of other are not its members
+func (d *DList[T]) checkSource(other *DList[T]) {
+	if (d.ownerFunc == nil) != (other.ownerFunc == nil) {
+		panic(fmt.Errorf("%w: DList %p other: %p", ErrModeMismatch, d, other))
+	}
+	if other.first != nil {
+		other.checkIsMember(other.first)
+		other.checkIsMember(other.last)
+	}
+}
@@ -0,0 +1,167 @@
+package dlist
+
+import (
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/fn"
+)
+
+type testAutoItem struct {
+	AutoUnlinkHook[testAutoItem]
+	value int
+}
+
+func autoHook(self *testAutoItem) *AutoUnlinkHook[testAutoItem] {
+	return &self.AutoUnlinkHook
+}
+
+// Auto-unlink DList head must not be copied after elements are inserted
+func newAutoListGenerate(count int, generator func(position int) int) (l *DList[testAutoItem]) {
+	l = new(DList[testAutoItem])
+	*l = NewAutoUnlink(autoHook)
+	for i := 0; i < count; i++ {
+		item := testAutoItem{AutoUnlinkHook: NewAutoUnlinkHook[testAutoItem](), value: generator(i)}
+		l.PushBack(&item)
+	}
+	return
+}
+
+func nextAuto(l *DList[testAutoItem]) func() int {
+	current := l.Front()
+	return func() int {
+		val := current.value
+		current = current.Next()
+		return val
+	}
+}
+
+func verifyOwner(t *testing.T, l *DList[testAutoItem]) {
+	for e := l.Front(); e != nil; e = e.Next() {
+		if e.Owner() != l || !e.IsLinked() {
+			t.Errorf("element %v has owner %p instead of %p", e.value, e.Owner(), l)
+		}
+	}
+}
+
+func TestAutoUnlinkNewHookIsNotLinked(t *testing.T) {
+	h := NewAutoUnlinkHook[testAutoItem]()
+	if h.IsLinked() || h.Owner() != nil {
+		t.Errorf("new auto-unlink hook is linked")
+	}
+	h.Unlink()
+}
+
+func TestAutoUnlinkPushAndPopTrackOwner(t *testing.T) {
+	l := NewAutoUnlink(autoHook)
+	a, b, c := testAutoItem{value: 0}, testAutoItem{value: 1}, testAutoItem{value: 2}
+	l.PushBack(&a)
+	l.PushFront(&b)
+	l.Insert(&a, &c)
+	verifyOwner(t, &l)
+
+	if p := l.PopFront(); p != &b || b.IsLinked() {
+		t.Errorf("popped front element is still linked")
+	}
+	if p := l.PopBack(); p != &a || a.IsLinked() {
+		t.Errorf("popped back element is still linked")
+	}
+	l.Erase(&c)
+	if c.IsLinked() || c.Owner() != nil {
+		t.Errorf("erased element is still linked")
+	}
+}
+
+func TestAutoUnlinkRemovesElement(t *testing.T) {
+	tests := map[string]struct {
+		at    int
+		order []int
+	}{
+		"front":  {0, []int{1, 2}},
+		"middle": {1, []int{0, 2}},
+		"back":   {2, []int{0, 1}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := newAutoListGenerate(3, increment(0))
+			e := l.Front()
+			for i := 0; i < testCase.at; i++ {
+				e = e.Next()
+			}
+			e.Unlink()
+			if e.IsLinked() || e.Next() != nil || e.Prev() != nil {
+				t.Errorf("unlinked element is still linked")
+			}
+			if v := fn.Apply(nextAuto(l), fn.I, l.Len()); !equalValues(v, testCase.order) {
+				t.Errorf("list after unlink has order %v", v)
+			}
+			e.Unlink()
+			if l.Len() != 2 {
+				t.Errorf("second unlink changed list size %v", l.Len())
+			}
+		})
+	}
+}
+
+func TestAutoUnlinkSingleElement(t *testing.T) {
+	l := newAutoListGenerate(1, increment(0))
+	l.Front().Unlink()
+	if !l.Empty() || l.Front() != nil || l.Back() != nil {
+		t.Errorf("list is not empty after unlinking single element")
+	}
+}
+
+func TestAutoUnlinkFromSeveralLists(t *testing.T) {
+	lists := []DList[testAutoItem]{NewAutoUnlink(autoHook), NewAutoUnlink(autoHook), NewAutoUnlink(autoHook)}
+	items := make([]testAutoItem, 9)
+	for i := range items {
+		items[i].value = i
+		lists[i%3].PushBack(&items[i])
+	}
+	for i := range items {
+		if items[i].Owner() != &lists[i%3] {
+			t.Errorf("element %v has unexpected owner", i)
+		}
+	}
+	items[4].Unlink()
+	items[6].Unlink()
+	if lists[1].Len() != 2 || lists[0].Len() != 2 || lists[2].Len() != 3 {
+		t.Errorf("unexpected sizes after unlink %v %v %v", lists[0].Len(), lists[1].Len(), lists[2].Len())
+	}
+	if v := fn.Apply(nextAuto(&lists[1]), fn.I, lists[1].Len()); !equalValues(v, []int{1, 7}) {
+		t.Errorf("list after unlink has order %v", v)
+	}
+}
+
+func TestAutoUnlinkOwnerUpdatedOnTransfer(t *testing.T) {
+	a := newAutoListGenerate(3, increment(0))
+	b := newAutoListGenerate(3, increment(10))
+	a.Splice(a.Front().Next(), b)
+	verifyOwner(t, a)
+
+	c := newAutoListGenerate(2, increment(20))
+	c.SpliceRange(nil, a, a.Front(), a.Front().Next())
+	verifyOwner(t, a)
+	verifyOwner(t, c)
+
+	d := newAutoListGenerate(2, increment(0))
+	d.Merge(c, func(lhs, rhs *testAutoItem) bool { return lhs.value < rhs.value })
+	verifyOwner(t, d)
+
+	d.Swap(a)
+	verifyOwner(t, a)
+	verifyOwner(t, d)
+
+	d.Front().Unlink()
+	a.Back().Unlink()
+	verifyOwner(t, a)
+	verifyOwner(t, d)
+	if a.Len() != 5 || d.Len() != 3 {
+		t.Errorf("unexpected sizes after transfer %v %v", a.Len(), d.Len())
+	}
+
+	for _, e := range a.Clear() {
+		if e.IsLinked() {
+			t.Errorf("cleared element %v is still linked", e.value)
+		}
+	}
+}
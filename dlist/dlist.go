@@ -10,6 +10,9 @@ type (
 	// Head structure of doubly-linked list intrusive container
 	DList[T any] struct {
 		hookFunc    func(*T) *Hook[T]
+		offset      uintptr
+		useOffset   bool
+		ownerFunc   func(*T) **DList[T]
 		size        int
 		first, last *T
 	}
@@ -70,9 +73,14 @@ func (s DList[T]) Len() int {
 // Swap content of two DList heads
 func (d *DList[T]) Swap(other *DList[T]) {
 	other.hookFunc, d.hookFunc = d.hookFunc, other.hookFunc
+	other.offset, d.offset = d.offset, other.offset
+	other.useOffset, d.useOffset = d.useOffset, other.useOffset
+	other.ownerFunc, d.ownerFunc = d.ownerFunc, other.ownerFunc
 	other.first, d.first = d.first, other.first
 	other.last, d.last = d.last, other.last
 	other.size, d.size = d.size, other.size
+	d.setOwner(d.first, d.last, d)
+	other.setOwner(other.first, other.last, other)
 }
 
 // Return first element in DList
@@ -85,8 +93,30 @@ func (d DList[T]) Back() *T {
 	return d.last
 }
 
+// Return element after specified one or nil if element is the last one in DList
+func (d DList[T]) Next(element *T) *T {
+	d.verifyIsMemberOfCurrent(element)
+	return d.hook(element).next
+}
+
+// Return element before specified one or nil if element is the first one in DList
+func (d DList[T]) Prev(element *T) *T {
+	d.verifyIsMemberOfCurrent(element)
+	return d.hook(element).prev
+}
+
+// Return copy of hook of element. Unlike Next and Prev it does not run debug verifiers,
+// so it MAY be used to inspect damaged DList
+func (d DList[T]) Hook(element *T) Hook[T] {
+	return *d.hook(element)
+}
+
 // Insert new element before specified position
 func (d *DList[T]) Insert(position, element *T) {
+	d.checkNotLinked(element)
+	if position != nil {
+		d.checkIsMember(position)
+	}
 	d.verifyElementNotLinked(element)
 	defer d.verifyIsMemberOfCurrent(element)
 	defer d.verifyNoCycle()
@@ -99,56 +129,60 @@ func (d *DList[T]) Insert(position, element *T) {
 
 	d.verifyIsMemberOfCurrent(position)
 
-	hook := d.hookFunc(element)
-	posHook := d.hookFunc(position)
+	hook := d.hook(element)
+	posHook := d.hook(position)
 
 	hook.next = position
 	hook.prev = posHook.prev
 
 	if posHook.prev != nil {
-		d.hookFunc(posHook.prev).next = element
+		d.hook(posHook.prev).next = element
 	} else {
 		d.first = element
 	}
 
 	posHook.prev = element
 	d.size++
+	d.setOwner(element, element, d)
 }
 
 // Remove element from DList
 func (d *DList[T]) Erase(element *T) {
+	d.checkIsMember(element)
 	d.verifyNotEmpty()
 	d.verifyIsMemberOfCurrent(element)
 	defer d.verifyElementNotLinked(element)
 	defer d.verifyNoCycle()
 	defer d.verifySize()
 
-	hook := d.hookFunc(element)
+	hook := d.hook(element)
 
 	if hook.prev != nil {
-		d.hookFunc(hook.prev).next = hook.next
+		d.hook(hook.prev).next = hook.next
 	} else {
 		d.first = hook.next
 	}
 
 	if hook.next != nil {
-		d.hookFunc(hook.next).prev = hook.prev
+		d.hook(hook.next).prev = hook.prev
 	} else {
 		d.last = hook.prev
 	}
 
 	hook.Init()
 	d.size--
+	d.setOwner(element, element, nil)
 }
 
 // Insert new element at the front of DList
 func (d *DList[T]) PushFront(element *T) {
+	d.checkNotLinked(element)
 	d.verifyElementNotLinked(element)
 	defer d.verifyIsMemberOfCurrent(element)
 	defer d.verifyNoCycle()
 	defer d.verifySize()
 
-	hook := d.hookFunc(element)
+	hook := d.hook(element)
 
 	if d.first == nil {
 		d.last = element
@@ -157,11 +191,12 @@ func (d *DList[T]) PushFront(element *T) {
 	} else {
 		hook.next = d.first
 		hook.prev = nil
-		d.hookFunc(d.first).prev = element
+		d.hook(d.first).prev = element
 	}
 
 	d.first = element
 	d.size++
+	d.setOwner(element, element, d)
 }
 
 // Remove and return element from the front of DList
@@ -174,10 +209,10 @@ func (d *DList[T]) PopFront() (popped *T) {
 	defer d.verifySize()
 
 	popped = d.first
-	hook := d.hookFunc(popped)
+	hook := d.hook(popped)
 
 	if hook.next != nil {
-		d.hookFunc(hook.next).prev = nil
+		d.hook(hook.next).prev = nil
 	} else {
 		d.last = nil
 	}
@@ -185,17 +220,19 @@ func (d *DList[T]) PopFront() (popped *T) {
 	d.first = hook.next
 	hook.Init()
 	d.size--
+	d.setOwner(popped, popped, nil)
 	return
 }
 
 // Insert new element at the back of DList
 func (d *DList[T]) PushBack(element *T) {
+	d.checkNotLinked(element)
 	d.verifyElementNotLinked(element)
 	defer d.verifyIsMemberOfCurrent(element)
 	defer d.verifyNoCycle()
 	defer d.verifySize()
 
-	hook := d.hookFunc(element)
+	hook := d.hook(element)
 
 	if d.last == nil {
 		d.first = element
@@ -204,11 +241,12 @@ func (d *DList[T]) PushBack(element *T) {
 	} else {
 		hook.next = nil
 		hook.prev = d.last
-		d.hookFunc(d.last).next = element
+		d.hook(d.last).next = element
 	}
 	d.last = element
 
 	d.size++
+	d.setOwner(element, element, d)
 }
 
 // Remove and return element from the back of DList
@@ -221,10 +259,10 @@ func (d *DList[T]) PopBack() (popped *T) {
 	defer d.verifySize()
 
 	popped = d.last
-	hook := d.hookFunc(popped)
+	hook := d.hook(popped)
 
 	if hook.prev != nil {
-		d.hookFunc(hook.prev).next = nil
+		d.hook(hook.prev).next = nil
 	} else {
 		d.first = nil
 	}
@@ -232,6 +270,7 @@ func (d *DList[T]) PopBack() (popped *T) {
 	d.last = hook.prev
 	hook.Init()
 	d.size--
+	d.setOwner(popped, popped, nil)
 	return
 }
 
@@ -250,6 +289,10 @@ func (d *DList[T]) Splice(position *T, other *DList[T]) {
 	if other.first == nil || d == other {
 		return
 	}
+	if position != nil {
+		d.checkIsMember(position)
+	}
+	d.checkSource(other)
 	other.verifyNotEmpty()
 	other.verifyNoCycle()
 	defer d.verifyNoCycle()
@@ -258,8 +301,8 @@ func (d *DList[T]) Splice(position *T, other *DList[T]) {
 	if position == nil {
 		// Append to the end
 		if d.last != nil {
-			d.hookFunc(other.first).prev = d.last
-			d.hookFunc(d.last).next = other.first
+			d.hook(other.first).prev = d.last
+			d.hook(d.last).next = other.first
 		} else {
 			d.first = other.first
 		}
@@ -267,25 +310,122 @@ func (d *DList[T]) Splice(position *T, other *DList[T]) {
 	} else {
 		d.verifyIsMemberOfCurrent(position)
 		// Insert before position
-		posHook := d.hookFunc(position)
-		otherFirstHook := d.hookFunc(other.first)
-		otherLastHook := d.hookFunc(other.last)
+		posHook := d.hook(position)
+		otherFirstHook := d.hook(other.first)
+		otherLastHook := d.hook(other.last)
 
 		otherFirstHook.prev = posHook.prev
 		otherLastHook.next = position
 
 		if posHook.prev != nil {
-			d.hookFunc(posHook.prev).next = other.first
+			d.hook(posHook.prev).next = other.first
 		} else {
 			d.first = other.first
 		}
 		posHook.prev = other.last
 	}
 
+	d.setOwner(other.first, other.last, d)
 	d.size += other.size
 	other.Init()
 }
 
+// Move elements in range [first, last] from other DList to be included in current DList before position.
+// Position nil means the end of current DList. Other MAY be current DList if position is not inside the range.
+//
+// Complexity is linear in size of the range if other is not current DList and constant otherwise
+func (d *DList[T]) SpliceRange(position *T, other *DList[T], first, last *T) {
+	d.checkSource(other)
+	other.checkIsMember(first)
+	other.checkIsMember(last)
+	other.verifyNotEmpty()
+	other.verifyIsRangeOfCurrent(first, last)
+	if position != nil {
+		d.checkIsMember(position)
+	}
+	if d == other {
+		d.verifyNotInRange(first, last, position)
+		if position == first || position == d.hook(last).next {
+			return
+		}
+	} else if position != nil {
+		d.verifyIsMemberOfCurrent(position)
+	}
+	defer d.verifyNoCycle()
+	defer d.verifySize()
+	defer other.verifyNoCycle()
+	defer other.verifySize()
+
+	count := 0
+	if d != other {
+		count = 1
+		for e := first; e != last; e = d.hook(e).next {
+			count++
+		}
+		d.setOwner(first, last, d)
+	}
+
+	// Unlink range from other
+	firstHook := d.hook(first)
+	lastHook := d.hook(last)
+	if firstHook.prev != nil {
+		d.hook(firstHook.prev).next = lastHook.next
+	} else {
+		other.first = lastHook.next
+	}
+	if lastHook.next != nil {
+		d.hook(lastHook.next).prev = firstHook.prev
+	} else {
+		other.last = firstHook.prev
+	}
+	other.size -= count
+
+	// Link range before position
+	if position == nil {
+		firstHook.prev = d.last
+		lastHook.next = nil
+		if d.last != nil {
+			d.hook(d.last).next = first
+		} else {
+			d.first = first
+		}
+		d.last = last
+	} else {
+		posHook := d.hook(position)
+		firstHook.prev = posHook.prev
+		lastHook.next = position
+		if posHook.prev != nil {
+			d.hook(posHook.prev).next = first
+		} else {
+			d.first = first
+		}
+		posHook.prev = last
+	}
+	d.size += count
+}
+
+// Move element of current DList to be placed before position. Position nil means the end of current DList
+func (d *DList[T]) MoveBefore(element, position *T) {
+	d.SpliceRange(position, d, element, element)
+}
+
+// Move element of current DList to be placed after position
+func (d *DList[T]) MoveAfter(element, position *T) {
+	d.checkIsMember(position)
+	d.verifyIsMemberOfCurrent(position)
+	d.SpliceRange(d.hook(position).next, d, element, element)
+}
+
+// Move element of current DList to the front of current DList
+func (d *DList[T]) MoveToFront(element *T) {
+	d.SpliceRange(d.first, d, element, element)
+}
+
+// Move element of current DList to the back of current DList
+func (d *DList[T]) MoveToBack(element *T) {
+	d.SpliceRange(nil, d, element, element)
+}
+
 // Clear DList and return all currently linked elements as slice.
 func (d *DList[T]) Clear() (elements []*T) {
 	d.verifyNoCycle()
@@ -294,7 +434,8 @@ func (d *DList[T]) Clear() (elements []*T) {
 	e := d.first
 	for e != nil {
 		elements = append(elements, e)
-		h := d.hookFunc(e)
+		d.setOwner(e, e, nil)
+		h := d.hook(e)
 		e = h.Next()
 		h.Init()
 	}
@@ -310,23 +451,24 @@ func (d *DList[T]) Reverse() {
 
 	current := d.first
 	for current != nil {
-		hook := d.hookFunc(current)
+		hook := d.hook(current)
 		hook.prev, hook.next = hook.next, hook.prev
 		current = hook.prev
 	}
 	d.first, d.last = d.last, d.first
 }
 
-// Merge sorted lists into current DList
-func (d *DList[T]) Merge(other *DList[T], less func(lhs, rhs *T) bool) {
-	if other.first == nil {
+func (d *DList[T]) merge(other *DList[T], takeRight func(left, right *T) bool) {
+	if other.first == nil || d == other {
 		return
 	}
+	d.checkSource(other)
 	d.verifyNoCycle()
 	other.verifyNoCycle()
 	defer d.verifyNoCycle()
 	defer d.verifySize()
 
+	d.setOwner(other.first, other.last, d)
 	if d.first == nil {
 		d.first = other.first
 		d.last = other.last
@@ -338,40 +480,32 @@ func (d *DList[T]) Merge(other *DList[T], less func(lhs, rhs *T) bool) {
 	var head, tail *T
 	a, b := d.first, other.first
 
-	// Determine the head of the merged list
-	if less(a, b) {
-		head = a
-		tail = a
-		a = d.hookFunc(a).next
-	} else {
-		head = b
-		tail = b
-		b = d.hookFunc(b).next
-	}
-
-	// Merge the two lists
+	// Merge the two lists, equal elements are taken from current DList first
 	for a != nil && b != nil {
-		if less(a, b) {
-			d.hookFunc(tail).next = a
-			d.hookFunc(a).prev = tail
-			tail = a
-			a = d.hookFunc(a).next
+		e := a
+		if takeRight(a, b) {
+			e = b
+			b = d.hook(b).next
 		} else {
-			d.hookFunc(tail).next = b
-			d.hookFunc(b).prev = tail
-			tail = b
-			b = d.hookFunc(b).next
+			a = d.hook(a).next
 		}
+		if tail != nil {
+			d.hook(tail).next = e
+		} else {
+			head = e
+		}
+		d.hook(e).prev = tail
+		tail = e
 	}
 
 	// Append the remaining elements
 	if a != nil {
-		d.hookFunc(tail).next = a
-		d.hookFunc(a).prev = tail
+		d.hook(tail).next = a
+		d.hook(a).prev = tail
 		tail = d.last
-	} else if b != nil {
-		d.hookFunc(tail).next = b
-		d.hookFunc(b).prev = tail
+	} else {
+		d.hook(tail).next = b
+		d.hook(b).prev = tail
 		tail = other.last
 	}
 
@@ -381,13 +515,28 @@ func (d *DList[T]) Merge(other *DList[T], less func(lhs, rhs *T) bool) {
 	other.Init()
 }
 
+// Merge sorted lists into current DList.
+//
+// Merge is stable: elements of current DList precede equal elements of other
+func (d *DList[T]) Merge(other *DList[T], less func(lhs, rhs *T) bool) {
+	d.merge(other, func(left, right *T) bool { return less(right, left) })
+}
+
+// Merge sorted lists into current DList using three-way comparison function
+// returning negative, zero or positive value when lhs is less than, equal to or greater than rhs.
+//
+// MergeFunc is stable: elements of current DList precede equal elements of other
+func (d *DList[T]) MergeFunc(other *DList[T], cmp func(lhs, rhs *T) int) {
+	d.merge(other, func(left, right *T) bool { return cmp(left, right) > 0 })
+}
+
 // Remove elements satisfying predicate and return all removed elements as slice
 func (d *DList[T]) RemoveIf(predicate func(value *T) bool) (elements []*T) {
 	elements = make([]*T, 0)
 
 	current := d.first
 	for current != nil {
-		next := d.hookFunc(current).next
+		next := d.hook(current).next
 		if predicate(current) {
 			elements = append(elements, current)
 			d.Erase(current)
@@ -401,8 +550,8 @@ func (d *DList[T]) RemoveIf(predicate func(value *T) bool) (elements []*T) {
 func (d *DList[T]) Unique(less func(lhs, rhs *T) bool) (elements []*T) {
 	elements = make([]*T, 0)
 	current := d.first
-	for current != nil && d.hookFunc(current).next != nil {
-		next := d.hookFunc(current).next
+	for current != nil && d.hook(current).next != nil {
+		next := d.hook(current).next
 		if !less(current, next) && !less(next, current) {
 			elements = append(elements, next)
 			d.Erase(next)
@@ -413,8 +562,10 @@ func (d *DList[T]) Unique(less func(lhs, rhs *T) bool) (elements []*T) {
 	return
 }
 
-// Sort current DList in place using merge sort
-func (d *DList[T]) Sort(less func(lhs, rhs *T) bool) {
+// Bottom-up merge sort: runs of doubling width are merged in place without recursion
+// or any allocation. Only next links are followed during merging, prev links are
+// rebuilt as elements are appended to the merged list
+func (d *DList[T]) sort(takeRight func(left, right *T) bool) {
 	if d.size <= 1 {
 		return
 	}
@@ -423,34 +574,71 @@ func (d *DList[T]) Sort(less func(lhs, rhs *T) bool) {
 	defer d.verifyNoCycle()
 	defer d.verifySize()
 
-	// Split the list into two halves
-	mid := d.size / 2
-	current := d.first
-	for i := 0; i < mid-1; i++ {
-		current = d.hookFunc(current).next
-	}
-
-	rightFirst := d.hookFunc(current).next
-	d.hookFunc(current).next = nil
-	d.hookFunc(rightFirst).prev = nil
+	list := d.first
+	for width := 1; ; width *= 2 {
+		p := list
+		var tail *T
+		list = nil
+		merges := 0
+
+		for p != nil {
+			merges++
+
+			// Run q starts right after run p of at most width elements
+			q := p
+			pSize := 0
+			for pSize < width && q != nil {
+				pSize++
+				q = d.hook(q).next
+			}
+			qSize := width
+
+			for pSize > 0 || qSize > 0 && q != nil {
+				var e *T
+				if pSize == 0 || qSize > 0 && q != nil && takeRight(p, q) {
+					e = q
+					q = d.hook(q).next
+					qSize--
+				} else {
+					e = p
+					p = d.hook(p).next
+					pSize--
+				}
+
+				if tail != nil {
+					d.hook(tail).next = e
+				} else {
+					list = e
+				}
+				d.hook(e).prev = tail
+				tail = e
+			}
+
+			p = q
+		}
+		d.hook(tail).next = nil
 
-	left := &DList[T]{
-		hookFunc: d.hookFunc,
-		first:    d.first,
-		last:     current,
-		size:     mid,
-	}
-	right := &DList[T]{
-		hookFunc: d.hookFunc,
-		first:    rightFirst,
-		last:     d.last,
-		size:     d.size - mid,
+		if merges <= 1 {
+			d.first = list
+			d.last = tail
+			return
+		}
 	}
+}
 
-	left.Sort(less)
-	right.Sort(less)
+// Sort current DList in place using bottom-up merge sort.
+//
+// Sort is stable: equal elements retain their relative order.
+// Sort performs O(n log n) comparisons and uses O(1) extra memory
+func (d *DList[T]) Sort(less func(lhs, rhs *T) bool) {
+	d.sort(func(left, right *T) bool { return less(right, left) })
+}
 
-	d.Init()
-	d.Merge(left, less)
-	d.Merge(right, less)
+// Sort current DList in place using three-way comparison function
+// returning negative, zero or positive value when lhs is less than, equal to or greater than rhs.
+//
+// SortFunc is stable: equal elements retain their relative order.
+// SortFunc performs O(n log n) comparisons and uses O(1) extra memory
+func (d *DList[T]) SortFunc(cmp func(lhs, rhs *T) int) {
+	d.sort(func(left, right *T) bool { return cmp(left, right) > 0 })
 }
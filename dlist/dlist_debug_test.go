@@ -0,0 +1,116 @@
+//go:build debug
+
+package dlist
+
+import (
+	"testing"
+)
+
+func TestDListVerifyNotEmptyPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifyNotEmpty should panic on empty list")
+		}
+	}()
+
+	e := newEmbedList()
+	e.verifyNotEmpty()
+}
+
+func TestDListVerifyElementNotLinkedPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifyElementNotLinked should panic on linked element")
+		}
+	}()
+
+	e := newEmbedListGenerate(2, increment(0))
+	element := e.Front()
+	e.verifyElementNotLinked(element)
+}
+
+func TestDListVerifyIsMemberOfCurrentPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifyIsMemberOfCurrent should panic for non-member element")
+		}
+	}()
+
+	e := newEmbedList()
+	element := newEmbed(0)
+	e.verifyIsMemberOfCurrent(&element)
+}
+
+func TestDListVerifySizePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifySize should panic on size mismatch")
+		}
+	}()
+
+	e := newEmbedListGenerate(2, increment(0))
+	e.size = 3 // Incorrect size
+	e.verifySize()
+}
+
+func TestDListVerifyNoCyclePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifyNoCycle should panic on cycle detection")
+		}
+	}()
+
+	e := newEmbedListGenerate(2, increment(0))
+	// Create a cycle manually
+	f, s := e.Front(), e.Back()
+	e.hookFunc(f).next = s
+	e.hookFunc(s).next = f // Creates cycle
+	e.verifyNoCycle()
+}
+
+func TestRingVerifyNotEmptyPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifyNotEmpty should panic on empty ring")
+		}
+	}()
+
+	e := newEmbedRing()
+	e.verifyNotEmpty()
+}
+
+func TestRingVerifyIsMemberOfCurrentPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifyIsMemberOfCurrent should panic for non-member element")
+		}
+	}()
+
+	e := newEmbedRingGenerate(2, increment(0))
+	element := newEmbed(0)
+	e.verifyIsMemberOfCurrent(&element)
+}
+
+func TestRingVerifySizePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifySize should panic on size mismatch")
+		}
+	}()
+
+	e := newEmbedRingGenerate(2, increment(0))
+	e.size = 3 // Incorrect size
+	e.verifySize()
+}
+
+func TestRingVerifyLinksPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("verifyLinks should panic on broken link")
+		}
+	}()
+
+	e := newEmbedRingGenerate(3, increment(0))
+	e.hookFunc(e.Front()).next = e.Back() // Skips middle element
+	e.verifyLinks()
+}
@@ -60,6 +60,10 @@ func elementAt(d DList[fuzzEmbedItem], pos int) (r *fuzzEmbedItem) {
 }
 
 func verifyListConsistency(t *testing.T, l *DList[fuzzEmbedItem]) {
+	if err := l.Validate(); err != nil {
+		t.Errorf("Validate failed: %v", err)
+	}
+
 	if l.Empty() {
 		if l.Front() != nil || l.Back() != nil || l.Size() != 0 {
 			t.Errorf("Empty list inconsistency: front=%v, back=%v, size=%d", l.Front(), l.Back(), l.Size())
@@ -0,0 +1,227 @@
+package dlist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/oracle"
+)
+
+const (
+	oracleOpInsert byte = iota
+	oracleOpErase
+	oracleOpPushFront
+	oracleOpPopFront
+	oracleOpPushBack
+	oracleOpPopBack
+	oracleOpSplice
+	oracleOpSpliceRange
+	oracleOpMoveToFront
+	oracleOpMoveToBack
+	oracleOpClear
+	oracleOpReverse
+	oracleOpMerge
+	oracleOpSort
+	oracleOpUnique
+	oracleOpRemoveIf
+	oracleOpCOUNT
+)
+
+func fuzzPredicate(kind byte) func(*fuzzEmbedItem) bool {
+	switch kind % 4 {
+	case 0:
+		return func(e *fuzzEmbedItem) bool { return e.value%2 == 0 }
+	case 1:
+		return func(e *fuzzEmbedItem) bool { return e.value%2 == 1 }
+	case 2:
+		return func(e *fuzzEmbedItem) bool { return e.value < 8 }
+	default:
+		return func(e *fuzzEmbedItem) bool { return e.value >= 8 }
+	}
+}
+
+func verifyRemoved(t *testing.T, got, want []*fuzzEmbedItem) {
+	if diff := oracle.Diff(got, want); diff != "" {
+		t.Fatalf("removed elements differ from model: %s", diff)
+	}
+}
+
+// Apply command to DList and to its reference model
+func oracleStep(t *testing.T, items []fuzzEmbedItem, lists []DList[fuzzEmbedItem], models []oracle.List[*fuzzEmbedItem]) func(command []byte) {
+	return func(command []byte) {
+		op, arg1, arg2, arg3, arg4, arg5 := command[0], int(command[1]), int(command[2]), int(command[3]), int(command[4]), int(command[5])
+		listIdx, otherIdx := arg1%len(lists), arg2%len(lists)
+		l, m := &lists[listIdx], &models[listIdx]
+		other, otherModel := &lists[otherIdx], &models[otherIdx]
+		item := &items[arg2%len(items)]
+		at := arg3 % (m.Len() + 1)
+
+		switch op % oracleOpCOUNT {
+		case oracleOpInsert:
+			if !item.isUsed {
+				l.Insert(m.At(at), item)
+				m.Insert(at, item)
+				item.isUsed = true
+			}
+
+		case oracleOpErase:
+			if e := m.At(at); e != nil {
+				l.Erase(e)
+				m.RemoveAt(at)
+				e.isUsed = false
+			}
+
+		case oracleOpPushFront:
+			if !item.isUsed {
+				l.PushFront(item)
+				m.PushFront(item)
+				item.isUsed = true
+			}
+
+		case oracleOpPopFront:
+			if e := l.PopFront(); e != nil {
+				e.isUsed = false
+			}
+			m.PopFront()
+
+		case oracleOpPushBack:
+			if !item.isUsed {
+				l.PushBack(item)
+				m.PushBack(item)
+				item.isUsed = true
+			}
+
+		case oracleOpPopBack:
+			if e := l.PopBack(); e != nil {
+				e.isUsed = false
+			}
+			m.PopBack()
+
+		case oracleOpSplice:
+			if l != other {
+				l.Splice(m.At(at), other)
+				m.Splice(at, otherModel)
+			}
+
+		case oracleOpSpliceRange:
+			if otherModel.Len() == 0 {
+				break
+			}
+			from := arg3 % otherModel.Len()
+			to := from + arg4%(otherModel.Len()-from)
+			first, last := otherModel.At(from), otherModel.At(to)
+			pos := m.At(arg5 % (m.Len() + 1))
+			if l == other && pos != nil {
+				if at := m.Index(pos); at >= from && at <= to {
+					break
+				}
+			}
+			l.SpliceRange(pos, other, first, last)
+			moved := otherModel.Cut(from, to+1)
+			if pos == nil {
+				m.InsertAll(m.Len(), moved)
+			} else {
+				m.InsertAll(m.Index(pos), moved)
+			}
+
+		case oracleOpMoveToFront:
+			if e := m.At(at); e != nil {
+				l.MoveToFront(e)
+				m.PushFront(m.RemoveAt(at))
+			}
+
+		case oracleOpMoveToBack:
+			if e := m.At(at); e != nil {
+				l.MoveToBack(e)
+				m.PushBack(m.RemoveAt(at))
+			}
+
+		case oracleOpClear:
+			removed := l.Clear()
+			for _, e := range removed {
+				e.isUsed = false
+			}
+			verifyRemoved(t, removed, m.Clear())
+
+		case oracleOpReverse:
+			l.Reverse()
+			m.Reverse()
+
+		case oracleOpMerge:
+			if l != other {
+				l.Sort(lessFuzz)
+				other.Sort(lessFuzz)
+				l.Merge(other, lessFuzz)
+				m.Sort(lessFuzz)
+				otherModel.Sort(lessFuzz)
+				m.Merge(otherModel, lessFuzz)
+			}
+
+		case oracleOpSort:
+			l.Sort(lessFuzz)
+			m.Sort(lessFuzz)
+
+		case oracleOpUnique:
+			if arg3%2 == 0 {
+				l.Sort(lessFuzz)
+				m.Sort(lessFuzz)
+			}
+			removed := l.Unique(lessFuzz)
+			for _, e := range removed {
+				e.isUsed = false
+			}
+			verifyRemoved(t, removed, m.Unique(lessFuzz))
+
+		case oracleOpRemoveIf:
+			removed := l.RemoveIf(fuzzPredicate(command[3]))
+			for _, e := range removed {
+				e.isUsed = false
+			}
+			verifyRemoved(t, removed, m.RemoveIf(fuzzPredicate(command[3])))
+		}
+	}
+}
+
+// Compare full contents of every DList with its reference model in both directions
+func oracleCheck(t *testing.T, lists []DList[fuzzEmbedItem], models []oracle.List[*fuzzEmbedItem]) func(step int) {
+	return func(step int) {
+		for i := range lists {
+			l, want := &lists[i], models[i].Elements()
+			forward := oracle.Collect(l.Front(), func(e *fuzzEmbedItem) *fuzzEmbedItem { return e.Next() })
+			if diff := oracle.Diff(forward, want); diff != "" {
+				t.Fatalf("step %d: list %d differs from model: %s", step, i, diff)
+			}
+			backward := oracle.Collect(l.Back(), func(e *fuzzEmbedItem) *fuzzEmbedItem { return e.Prev() })
+			slices.Reverse(backward)
+			if diff := oracle.Diff(backward, want); diff != "" {
+				t.Fatalf("step %d: list %d in reverse differs from model: %s", step, i, diff)
+			}
+			if l.Len() != len(want) {
+				t.Fatalf("step %d: list %d has size %d while model has %d", step, i, l.Len(), len(want))
+			}
+		}
+	}
+}
+
+func FuzzDListOracle(f *testing.F) {
+	const numItems = 64
+	const numLists = 4
+
+	for _, seed := range oracle.Seeds(32, 512) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, commands []byte) {
+		items := make([]fuzzEmbedItem, numItems)
+		for i := range items {
+			items[i] = newFuzz(i%16, i)
+		}
+		lists := make([]DList[fuzzEmbedItem], numLists)
+		for i := range lists {
+			lists[i] = newFuzzList()
+		}
+		models := make([]oracle.List[*fuzzEmbedItem], numLists)
+
+		oracle.Replay(commands, 6, oracleStep(t, items, lists, models), oracleCheck(t, lists, models))
+	})
+}
@@ -0,0 +1,19 @@
+//go:build !debug
+
+package dlist
+
+import (
+	"testing"
+)
+
+func TestDListSortDoNotAllocate(t *testing.T) {
+	l, _ := newStableListGenerate(1024, func(p int) int { return (p * 7919) % 1024 })
+	less := func(lhs, rhs *testStableItem) bool { return lhs.key < rhs.key }
+	greater := func(lhs, rhs *testStableItem) bool { return lhs.key > rhs.key }
+	if allocs := testing.AllocsPerRun(10, func() {
+		l.Sort(less)
+		l.Sort(greater)
+	}); allocs != 0 {
+		t.Errorf("sort allocated %v times per run", allocs)
+	}
+}
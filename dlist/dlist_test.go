@@ -625,6 +625,15 @@ func TestDListTwoElementListHasCorrectPrevPointers(t *testing.T) {
 	}
 }
 
+func TestDListHookReturnsLinks(t *testing.T) {
+	ml := newMemberListGenerate(3, increment(0))
+	mf, mb := ml.Front(), ml.Back()
+	mm := ml.Hook(mf).Next()
+	if mm != mf.hook.Next() || ml.Hook(mm).Prev() != mf || ml.Hook(mm).Next() != mb || ml.Hook(mb).Next() != nil {
+		t.Errorf("member element list hook links are not the same as in element")
+	}
+}
+
 func TestDListThreeElementListHasCorrectPointers(t *testing.T) {
 	e := newEmbedListGenerate(3, increment(0))
 	f, m, b := e.Front(), e.Front().Next(), e.Back()
@@ -1698,64 +1707,261 @@ func TestDListSizeMethod(t *testing.T) {
 	}
 }
 
-func TestDListVerifyNotEmptyPanic(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("verifyNotEmpty should panic on empty list")
-		}
-	}()
+func elementAtEmbed(l DList[testEmbedItem], pos int) (e *testEmbedItem) {
+	e = l.Front()
+	for i := 0; i < pos; i++ {
+		e = e.Next()
+	}
+	return
+}
 
-	e := newEmbedList()
-	e.verifyNotEmpty()
+func elementAtMember(l DList[testMemberItem], pos int) (m *testMemberItem) {
+	m = l.Front()
+	for i := 0; i < pos; i++ {
+		m = m.hook.Next()
+	}
+	return
 }
 
-func TestDListVerifyElementNotLinkedPanic(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("verifyElementNotLinked should panic on linked element")
-		}
-	}()
+func reversed(values []int) []int {
+	r := make([]int, len(values))
+	for i, v := range values {
+		r[len(values)-1-i] = v
+	}
+	return r
+}
+
+func TestDListSpliceRangeFromOtherList(t *testing.T) {
+	tests := map[string]struct {
+		listSizes   [2]int
+		at          int // -1 means nil position
+		first, last int
+		order       []int
+		otherOrder  []int
+	}{
+		"whole-into-empty":   {[2]int{0, 3}, -1, 0, 2, []int{10, 11, 12}, []int{}},
+		"prefix-to-back":     {[2]int{2, 3}, -1, 0, 1, []int{0, 1, 10, 11}, []int{12}},
+		"suffix-to-front":    {[2]int{2, 3}, 0, 1, 2, []int{11, 12, 0, 1}, []int{10}},
+		"middle-to-middle":   {[2]int{2, 3}, 1, 1, 1, []int{0, 11, 1}, []int{10, 12}},
+		"whole-to-middle":    {[2]int{3, 2}, 2, 0, 1, []int{0, 1, 10, 11, 2}, []int{}},
+		"single-to-back-one": {[2]int{1, 1}, -1, 0, 0, []int{0, 10}, []int{}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedListGenerate(testCase.listSizes[0], increment(0))
+			eo := newEmbedListGenerate(testCase.listSizes[1], increment(10))
+			var pos *testEmbedItem
+			if testCase.at >= 0 {
+				pos = elementAtEmbed(e, testCase.at)
+			}
+			e.SpliceRange(pos, &eo, elementAtEmbed(eo, testCase.first), elementAtEmbed(eo, testCase.last))
+			if v := fn.Apply(nextEmbed(e), fn.I, e.Len()); !equalValues(v, testCase.order) {
+				t.Errorf("embedded element list after splicerange has order %v", v)
+			}
+			if v := fn.Apply(prevEmbed(e), fn.I, e.Len()); !equalValues(v, reversed(testCase.order)) {
+				t.Errorf("embedded element list after splicerange has reverse order %v", v)
+			}
+			if v := fn.Apply(nextEmbed(eo), fn.I, eo.Len()); !equalValues(v, testCase.otherOrder) {
+				t.Errorf("embedded element other list after splicerange has order %v", v)
+			}
+			if v := fn.Apply(prevEmbed(eo), fn.I, eo.Len()); !equalValues(v, reversed(testCase.otherOrder)) {
+				t.Errorf("embedded element other list after splicerange has reverse order %v", v)
+			}
+
+			m := newMemberListGenerate(testCase.listSizes[0], increment(0))
+			mo := newMemberListGenerate(testCase.listSizes[1], increment(10))
+			var mpos *testMemberItem
+			if testCase.at >= 0 {
+				mpos = elementAtMember(m, testCase.at)
+			}
+			m.SpliceRange(mpos, &mo, elementAtMember(mo, testCase.first), elementAtMember(mo, testCase.last))
+			if v := fn.Apply(nextMember(m), fn.I, m.Len()); !equalValues(v, testCase.order) {
+				t.Errorf("member element list after splicerange has order %v", v)
+			}
+			if v := fn.Apply(prevMember(mo), fn.I, mo.Len()); !equalValues(v, reversed(testCase.otherOrder)) {
+				t.Errorf("member element other list after splicerange has reverse order %v", v)
+			}
+		})
+	}
+}
+
+func TestDListSpliceRangeWithinList(t *testing.T) {
+	tests := map[string]struct {
+		at          int // -1 means nil position
+		first, last int
+		order       []int
+	}{
+		"to-front":           {0, 2, 3, []int{2, 3, 0, 1, 4}},
+		"to-back":            {-1, 0, 1, []int{2, 3, 4, 0, 1}},
+		"before-itself":      {1, 1, 2, []int{0, 1, 2, 3, 4}},
+		"after-itself":       {3, 1, 2, []int{0, 1, 2, 3, 4}},
+		"back-to-back":       {-1, 3, 4, []int{0, 1, 2, 3, 4}},
+		"forward-in-middle":  {4, 0, 1, []int{2, 3, 0, 1, 4}},
+		"backward-in-middle": {1, 3, 3, []int{0, 3, 1, 2, 4}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedListGenerate(5, increment(0))
+			var pos *testEmbedItem
+			if testCase.at >= 0 {
+				pos = elementAtEmbed(e, testCase.at)
+			}
+			e.SpliceRange(pos, &e, elementAtEmbed(e, testCase.first), elementAtEmbed(e, testCase.last))
+			if e.Len() != 5 {
+				t.Errorf("embedded element list after splicerange has size %v", e.Len())
+			}
+			if v := fn.Apply(nextEmbed(e), fn.I, e.Len()); !equalValues(v, testCase.order) {
+				t.Errorf("embedded element list after splicerange has order %v", v)
+			}
+			if v := fn.Apply(prevEmbed(e), fn.I, e.Len()); !equalValues(v, reversed(testCase.order)) {
+				t.Errorf("embedded element list after splicerange has reverse order %v", v)
+			}
+
+			m := newMemberListGenerate(5, increment(0))
+			var mpos *testMemberItem
+			if testCase.at >= 0 {
+				mpos = elementAtMember(m, testCase.at)
+			}
+			m.SpliceRange(mpos, &m, elementAtMember(m, testCase.first), elementAtMember(m, testCase.last))
+			if v := fn.Apply(nextMember(m), fn.I, m.Len()); !equalValues(v, testCase.order) {
+				t.Errorf("member element list after splicerange has order %v", v)
+			}
+		})
+	}
+}
+
+func TestDListMoveElements(t *testing.T) {
+	tests := map[string]struct {
+		move  func(l *DList[testEmbedItem])
+		order []int
+	}{
+		"move-to-front-back": {func(l *DList[testEmbedItem]) { l.MoveToFront(l.Back()) }, []int{3, 0, 1, 2}},
+		"move-to-front-same": {func(l *DList[testEmbedItem]) { l.MoveToFront(l.Front()) }, []int{0, 1, 2, 3}},
+		"move-to-back-front": {func(l *DList[testEmbedItem]) { l.MoveToBack(l.Front()) }, []int{1, 2, 3, 0}},
+		"move-to-back-same":  {func(l *DList[testEmbedItem]) { l.MoveToBack(l.Back()) }, []int{0, 1, 2, 3}},
+		"move-before":        {func(l *DList[testEmbedItem]) { l.MoveBefore(elementAtEmbed(*l, 3), elementAtEmbed(*l, 1)) }, []int{0, 3, 1, 2}},
+		"move-before-nil":    {func(l *DList[testEmbedItem]) { l.MoveBefore(elementAtEmbed(*l, 1), nil) }, []int{0, 2, 3, 1}},
+		"move-after":         {func(l *DList[testEmbedItem]) { l.MoveAfter(elementAtEmbed(*l, 0), elementAtEmbed(*l, 2)) }, []int{1, 2, 0, 3}},
+		"move-after-back":    {func(l *DList[testEmbedItem]) { l.MoveAfter(elementAtEmbed(*l, 1), l.Back()) }, []int{0, 2, 3, 1}},
+		"move-after-itself":  {func(l *DList[testEmbedItem]) { l.MoveAfter(elementAtEmbed(*l, 1), elementAtEmbed(*l, 1)) }, []int{0, 1, 2, 3}},
+		"move-after-prev":    {func(l *DList[testEmbedItem]) { l.MoveAfter(elementAtEmbed(*l, 2), elementAtEmbed(*l, 1)) }, []int{0, 1, 2, 3}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedListGenerate(4, increment(0))
+			testCase.move(&e)
+			if e.Len() != 4 {
+				t.Errorf("embedded element list after move has size %v", e.Len())
+			}
+			if v := fn.Apply(nextEmbed(e), fn.I, e.Len()); !equalValues(v, testCase.order) {
+				t.Errorf("embedded element list after move has order %v", v)
+			}
+			if v := fn.Apply(prevEmbed(e), fn.I, e.Len()); !equalValues(v, reversed(testCase.order)) {
+				t.Errorf("embedded element list after move has reverse order %v", v)
+			}
+		})
+	}
 
-	e := newEmbedListGenerate(1, increment(0))
-	element := e.Front()
-	e.verifyElementNotLinked(element)
+	m := newMemberListGenerate(3, increment(0))
+	m.MoveToBack(m.Front())
+	m.MoveToFront(elementAtMember(m, 1))
+	if v := fn.Apply(nextMember(m), fn.I, m.Len()); !equalValues(v, []int{2, 1, 0}) {
+		t.Errorf("member element list after move has order %v", v)
+	}
 }
 
-func TestDListVerifyIsMemberOfCurrentPanic(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("verifyIsMemberOfCurrent should panic for non-member element")
-		}
-	}()
+type testStableItem struct {
+	Hook[testStableItem]
+	key, order int
+}
 
-	e := newEmbedList()
-	element := newEmbed(0)
-	e.verifyIsMemberOfCurrent(&element)
+func stableHook(self *testStableItem) *Hook[testStableItem] {
+	return &self.Hook
 }
 
-func TestDListVerifySizePanic(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("verifySize should panic on size mismatch")
+func newStableListGenerate(count int, key func(position int) int) (l DList[testStableItem], items []testStableItem) {
+	l = New(stableHook)
+	items = make([]testStableItem, count)
+	for i := range items {
+		items[i] = testStableItem{key: key(i), order: i}
+		l.PushBack(&items[i])
+	}
+	return
+}
+
+func verifyStableOrder(t *testing.T, l DList[testStableItem], size int) {
+	if l.Len() != size {
+		t.Errorf("list has size %v instead of %v", l.Len(), size)
+	}
+	count := 0
+	var prev *testStableItem
+	for e := l.Front(); e != nil; e = e.Next() {
+		if e.Prev() != prev {
+			t.Errorf("prev pointer incorrect for element %v", e.order)
+		}
+		if prev != nil && (prev.key > e.key || prev.key == e.key && prev.order > e.order) {
+			t.Errorf("elements are not stably sorted: (%v, %v) before (%v, %v)", prev.key, prev.order, e.key, e.order)
 		}
-	}()
+		prev = e
+		count++
+	}
+	if prev != l.Back() || count != size {
+		t.Errorf("list back or traversed size %v is incorrect", count)
+	}
+}
 
-	e := newEmbedListGenerate(2, increment(0))
-	e.size = 3 // Incorrect size
-	e.verifySize()
+func TestDListSortIsStable(t *testing.T) {
+	for _, size := range []int{2, 3, 7, 16, 33, 100, 1000} {
+		l, _ := newStableListGenerate(size, func(p int) int { return (p * 7919) % 5 })
+		l.Sort(func(lhs, rhs *testStableItem) bool { return lhs.key < rhs.key })
+		verifyStableOrder(t, l, size)
+	}
 }
 
-func TestDListVerifyNoCyclePanic(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Error("verifyNoCycle should panic on cycle detection")
-		}
-	}()
+func TestDListSortFuncIsStable(t *testing.T) {
+	for _, size := range []int{2, 5, 8, 31, 64, 257} {
+		l, _ := newStableListGenerate(size, func(p int) int { return size - p%3 })
+		l.SortFunc(func(lhs, rhs *testStableItem) int { return lhs.key - rhs.key })
+		verifyStableOrder(t, l, size)
+	}
+}
 
-	e := newEmbedListGenerate(2, increment(0))
-	// Create a cycle manually
-	f, s := e.Front(), e.Back()
-	e.hookFunc(f).next = s
-	e.hookFunc(s).next = f // Creates cycle
-	e.verifyNoCycle()
+func TestDListSortFuncOrdersElementsCorrectly(t *testing.T) {
+	e := newEmbedListGenerate(9, func(p int) int { return (p * 5) % 9 })
+	e.SortFunc(func(lhs, rhs *testEmbedItem) int { return lhs.value - rhs.value })
+	if v := fn.Apply(nextEmbed(e), fn.I, e.Len()); !equalValues(v, []int{0, 1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("embedded element list sortfunc has order %v", v)
+	}
+	if v := fn.Apply(prevEmbed(e), fn.I, e.Len()); !equalValues(v, []int{8, 7, 6, 5, 4, 3, 2, 1, 0}) {
+		t.Errorf("embedded element list sortfunc has reverse order %v", v)
+	}
+
+	m := newMemberListGenerate(4, decrement(4))
+	m.SortFunc(func(lhs, rhs *testMemberItem) int { return rhs.value - lhs.value })
+	if v := fn.Apply(nextMember(m), fn.I, m.Len()); !equalValues(v, []int{4, 3, 2, 1}) {
+		t.Errorf("member element list sortfunc descending has order %v", v)
+	}
+}
+
+func TestDListMergeIsStable(t *testing.T) {
+	l, _ := newStableListGenerate(6, func(p int) int { return p / 2 })
+	o := New(stableHook)
+	items := []testStableItem{{key: 0, order: 10}, {key: 1, order: 11}, {key: 3, order: 12}}
+	for i := range items {
+		o.PushBack(&items[i])
+	}
+	l.Merge(&o, func(lhs, rhs *testStableItem) bool { return lhs.key < rhs.key })
+	verifyStableOrder(t, l, 9)
+	if !o.Empty() {
+		t.Errorf("merged list is not empty")
+	}
+
+	lf, _ := newStableListGenerate(4, func(p int) int { return p })
+	of := New(stableHook)
+	itemsf := []testStableItem{{key: 1, order: 10}, {key: 2, order: 11}}
+	for i := range itemsf {
+		of.PushBack(&itemsf[i])
+	}
+	lf.MergeFunc(&of, func(lhs, rhs *testStableItem) int { return lhs.key - rhs.key })
+	verifyStableOrder(t, lf, 6)
 }
@@ -0,0 +1,43 @@
+package dlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// Error that safe-mode DList panics with when already linked element is inserted
+	ErrAlreadyLinked = errors.New("dlist: element is already linked")
+	// Error that safe-mode DList panics with when element of other container is erased or used as position
+	ErrNotMember = errors.New("dlist: element is not a member of the list")
+	// Error that DList panics with when elements are moved between auto-unlink and plain DList
+	ErrModeMismatch = errors.New("dlist: auto-unlink and plain lists are mixed")
+	// Error returned by Validate when elements of DList form a cycle
+	ErrCycle = errors.New("dlist: cycle detected")
+	// Error returned by Validate when number of linked elements is not equal to the size of DList
+	ErrSizeMismatch = errors.New("dlist: size mismatch")
+	// Error returned by Validate when links of element or head of DList are inconsistent
+	ErrBrokenLink = errors.New("dlist: broken link")
+)
+
+type (
+	// Error returned by Validate that holds the offending element.
+	// Element MAY be nil if error is not related to a particular element
+	ValidationError[T any] struct {
+		Err     error
+		Element *T
+	}
+)
+
+// Return error description with address of the offending element
+func (e *ValidationError[T]) Error() string {
+	if e.Element == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v: element: %p", e.Err, e.Element)
+}
+
+// Return underlying sentinel error
+func (e *ValidationError[T]) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,35 @@
+package dlist
+
+import (
+	"unsafe"
+)
+
+// Create new DList container of elements which Hook is located at offset from the start of element.
+//
+// Offset SHOULD be computed with unsafe.Offsetof, e.g. unsafe.Offsetof(item.hook).
+// Hook is accessed with pointer arithmetic instead of a call of hookFunc on every step
+// of every operation
+func NewOffset[T any](offset uintptr) DList[T] {
+	return DList[T]{hookFunc: nil, offset: offset, useOffset: true, size: 0, first: nil, last: nil}
+}
+
+// Return hook of element using hookFunc or offset if DList is created with NewOffset
+func (d *DList[T]) hook(element *T) *Hook[T] {
+	if d.useOffset {
+		return (*Hook[T])(unsafe.Add(unsafe.Pointer(element), d.offset))
+	}
+	return d.hookFunc(element)
+}
+
+// Create new Ring container of elements which Hook is located at offset from the start of element
+func NewRingOffset[T any](offset uintptr) Ring[T] {
+	return Ring[T]{hookFunc: nil, offset: offset, useOffset: true, size: 0, front: nil}
+}
+
+// Return hook of element using hookFunc or offset if Ring is created with NewRingOffset
+func (r *Ring[T]) hook(element *T) *Hook[T] {
+	if r.useOffset {
+		return (*Hook[T])(unsafe.Add(unsafe.Pointer(element), r.offset))
+	}
+	return r.hookFunc(element)
+}
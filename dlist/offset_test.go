@@ -0,0 +1,163 @@
+package dlist
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"unsafe"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/fn"
+)
+
+// Hook is intentionally not the first field to test non-zero offset
+type testOffsetItem struct {
+	value int
+	hook  Hook[testOffsetItem]
+}
+
+var testOffset = unsafe.Offsetof(testOffsetItem{}.hook)
+
+func offsetHook(self *testOffsetItem) *Hook[testOffsetItem] {
+	return &self.hook
+}
+
+func newOffsetListGenerate(count int, generator func(position int) int) (l DList[testOffsetItem]) {
+	l = NewOffset[testOffsetItem](testOffset)
+	for i := 0; i < count; i++ {
+		l.PushBack(&testOffsetItem{value: generator(i)})
+	}
+	return
+}
+
+func nextOffset(l DList[testOffsetItem]) func() int {
+	current := l.Front()
+	return func() int {
+		defer func() { current = current.hook.Next() }()
+		return current.value
+	}
+}
+
+func prevOffset(l DList[testOffsetItem]) func() int {
+	current := l.Back()
+	return func() int {
+		defer func() { current = current.hook.Prev() }()
+		return current.value
+	}
+}
+
+func lessOffset(lhs, rhs *testOffsetItem) bool {
+	return lhs.value < rhs.value
+}
+
+func TestOffsetDListPushAndPop(t *testing.T) {
+	l := newOffsetListGenerate(3, increment(0))
+	f := testOffsetItem{value: -1}
+	l.PushFront(&f)
+	if v := fn.Apply(nextOffset(l), fn.I, l.Len()); !equalValues(v, []int{-1, 0, 1, 2}) {
+		t.Errorf("offset list has order %v", v)
+	}
+	if v := fn.Apply(prevOffset(l), fn.I, l.Len()); !equalValues(v, []int{2, 1, 0, -1}) {
+		t.Errorf("offset list has reverse order %v", v)
+	}
+	if p := l.PopFront(); p != &f || f.hook.Next() != nil {
+		t.Errorf("offset list pop front returned unexpected element")
+	}
+	if p := l.PopBack(); p.value != 2 || l.Len() != 2 {
+		t.Errorf("offset list pop back returned %v", p.value)
+	}
+	if err := l.Validate(); err != nil {
+		t.Errorf("offset list is invalid: %v", err)
+	}
+}
+
+func TestOffsetDListSort(t *testing.T) {
+	l := newOffsetListGenerate(100, func(int) int { return rand.Intn(50) })
+	l.Sort(lessOffset)
+	if !isSorted(nextOffset(l), l.Len()) {
+		t.Errorf("offset list is not sorted")
+	}
+	if err := l.Validate(); err != nil {
+		t.Errorf("offset list is invalid after sort: %v", err)
+	}
+}
+
+func TestOffsetDListSwapWithHookFuncList(t *testing.T) {
+	o := newOffsetListGenerate(2, increment(0))
+	h := New(offsetHook)
+	h.PushBack(&testOffsetItem{value: 10})
+	o.Swap(&h)
+	e := testOffsetItem{value: 20}
+	o.PushBack(&e)
+	h.PushBack(&testOffsetItem{value: 2})
+	if v := fn.Apply(nextOffset(o), fn.I, o.Len()); !equalValues(v, []int{10, 20}) {
+		t.Errorf("list after swap has order %v", v)
+	}
+	if v := fn.Apply(nextOffset(h), fn.I, h.Len()); !equalValues(v, []int{0, 1, 2}) {
+		t.Errorf("offset list after swap has order %v", v)
+	}
+}
+
+func TestOffsetRing(t *testing.T) {
+	r := NewRingOffset[testOffsetItem](testOffset)
+	for i := 0; i < 4; i++ {
+		r.PushBack(&testOffsetItem{value: i})
+	}
+	r.Rotate(-1)
+	var v []int
+	r.Do(func(e *testOffsetItem) { v = append(v, e.value) })
+	if !equalValues(v, []int{3, 0, 1, 2}) {
+		t.Errorf("offset ring has order %v", v)
+	}
+	if r.Back().value != 2 || r.Next(r.Back()).value != 3 || r.Prev(r.Front()).value != 2 {
+		t.Errorf("offset ring is not circular")
+	}
+	h := NewRing(offsetHook)
+	r.Swap(&h)
+	h.Erase(h.Front())
+	if p := h.PopBack(); h.Len() != 2 || p.value != 2 || h.Front().value != 0 {
+		t.Errorf("offset ring after swap has size %v", h.Len())
+	}
+}
+
+// Zero value DList has neither hookFunc nor offset and MUST NOT access memory at offset 0
+func TestOffsetZeroValueDListPanics(t *testing.T) {
+	var l DList[testOffsetItem]
+	item := testOffsetItem{value: 1}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("zero value DList accepted element")
+		}
+		if item.value != 1 || item.hook != (Hook[testOffsetItem]{}) {
+			t.Errorf("zero value DList modified memory of element")
+		}
+	}()
+	l.PushBack(&item)
+}
+
+func benchmarkDListSort(b *testing.B, size int, newList func() DList[testOffsetItem]) {
+	items := make([]testOffsetItem, size)
+	values := rand.Perm(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		l := newList()
+		for j := range items {
+			items[j] = testOffsetItem{value: values[j]}
+			l.PushBack(&items[j])
+		}
+		b.StartTimer()
+		l.Sort(lessOffset)
+	}
+}
+
+func BenchmarkDListSort(b *testing.B) {
+	for _, size := range []int{100, 10000} {
+		b.Run(fmt.Sprintf("hookFunc/%d", size), func(b *testing.B) {
+			benchmarkDListSort(b, size, func() DList[testOffsetItem] { return New(offsetHook) })
+		})
+		b.Run(fmt.Sprintf("offset/%d", size), func(b *testing.B) {
+			benchmarkDListSort(b, size, func() DList[testOffsetItem] { return NewOffset[testOffsetItem](testOffset) })
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package dlist
+
+type (
+	// Head structure of circular doubly-linked list intrusive container.
+	//
+	// Ring uses the same Hook as DList but links of its elements are never nil:
+	// the back element points to the front element and vice versa
+	Ring[T any] struct {
+		hookFunc  func(*T) *Hook[T]
+		offset    uintptr
+		useOffset bool
+		size      int
+		front     *T
+	}
+)
+
+// Create new Ring container
+func NewRing[T any](hookFunc func(*T) *Hook[T]) Ring[T] {
+	return Ring[T]{hookFunc: hookFunc, size: 0, front: nil}
+}
+
+// Initialize Ring to empty state
+func (r *Ring[T]) Init() {
+	r.front = nil
+	r.size = 0
+}
+
+// Check if Ring is empty
+func (r Ring[T]) Empty() bool {
+	return r.size == 0
+}
+
+// Get current length of Ring
+func (r Ring[T]) Size() int {
+	return r.size
+}
+
+// Get current length of Ring
+func (r Ring[T]) Len() int {
+	return r.size
+}
+
+// Swap content of two Ring heads
+func (r *Ring[T]) Swap(other *Ring[T]) {
+	other.hookFunc, r.hookFunc = r.hookFunc, other.hookFunc
+	other.offset, r.offset = r.offset, other.offset
+	other.useOffset, r.useOffset = r.useOffset, other.useOffset
+	other.front, r.front = r.front, other.front
+	other.size, r.size = r.size, other.size
+}
+
+// Return element at the cursor of Ring
+func (r Ring[T]) Front() *T {
+	return r.front
+}
+
+// Return element right before the cursor of Ring
+func (r Ring[T]) Back() *T {
+	if r.front == nil {
+		return nil
+	}
+	return r.hook(r.front).prev
+}
+
+// Return element after specified one wrapping around at the back of Ring
+func (r Ring[T]) Next(element *T) *T {
+	r.verifyIsMemberOfCurrent(element)
+	return r.hook(element).next
+}
+
+// Return element before specified one wrapping around at the front of Ring
+func (r Ring[T]) Prev(element *T) *T {
+	r.verifyIsMemberOfCurrent(element)
+	return r.hook(element).prev
+}
+
+func (r *Ring[T]) link(position, element *T) {
+	hook := r.hook(element)
+	if position == nil {
+		hook.next = element
+		hook.prev = element
+		r.front = element
+	} else {
+		posHook := r.hook(position)
+		hook.next = position
+		hook.prev = posHook.prev
+		r.hook(posHook.prev).next = element
+		posHook.prev = element
+	}
+	r.size++
+}
+
+func (r *Ring[T]) unlink(element *T) {
+	hook := r.hook(element)
+	if hook.next == element {
+		r.front = nil
+	} else {
+		r.hook(hook.prev).next = hook.next
+		r.hook(hook.next).prev = hook.prev
+		if r.front == element {
+			r.front = hook.next
+		}
+	}
+	hook.Init()
+	r.size--
+}
+
+// Insert new element before specified position.
+// Position nil inserts element at the back of Ring
+func (r *Ring[T]) Insert(position, element *T) {
+	if position == nil {
+		r.PushBack(element)
+		return
+	}
+	r.verifyElementNotLinked(element)
+	r.verifyIsMemberOfCurrent(position)
+	defer r.verifyIsMemberOfCurrent(element)
+	defer r.verifyLinks()
+	defer r.verifySize()
+
+	r.link(position, element)
+}
+
+// Remove element from Ring. If element is at the cursor, cursor moves to the next element
+func (r *Ring[T]) Erase(element *T) {
+	r.verifyNotEmpty()
+	r.verifyIsMemberOfCurrent(element)
+	defer r.verifyElementNotLinked(element)
+	defer r.verifyLinks()
+	defer r.verifySize()
+
+	r.unlink(element)
+}
+
+// Insert new element at the cursor of Ring
+func (r *Ring[T]) PushFront(element *T) {
+	r.verifyElementNotLinked(element)
+	defer r.verifyIsMemberOfCurrent(element)
+	defer r.verifyLinks()
+	defer r.verifySize()
+
+	r.link(r.front, element)
+	r.front = element
+}
+
+// Remove and return element at the cursor of Ring
+func (r *Ring[T]) PopFront() (popped *T) {
+	if r.front == nil {
+		return nil
+	}
+	r.verifyNotEmpty()
+	defer r.verifyLinks()
+	defer r.verifySize()
+
+	popped = r.front
+	r.unlink(popped)
+	return
+}
+
+// Insert new element right before the cursor of Ring
+func (r *Ring[T]) PushBack(element *T) {
+	r.verifyElementNotLinked(element)
+	defer r.verifyIsMemberOfCurrent(element)
+	defer r.verifyLinks()
+	defer r.verifySize()
+
+	r.link(r.front, element)
+}
+
+// Remove and return element right before the cursor of Ring
+func (r *Ring[T]) PopBack() (popped *T) {
+	if r.front == nil {
+		return nil
+	}
+	r.verifyNotEmpty()
+	defer r.verifyLinks()
+	defer r.verifySize()
+
+	popped = r.hook(r.front).prev
+	r.unlink(popped)
+	return
+}
+
+// Advance the cursor of Ring by n elements forward or by -n elements backward if n is negative
+func (r *Ring[T]) Rotate(n int) {
+	if r.size <= 1 {
+		return
+	}
+	defer r.verifyLinks()
+
+	n %= r.size
+	if n < 0 {
+		n += r.size
+	}
+	// Walk in the direction with less steps
+	if n <= r.size/2 {
+		for ; n > 0; n-- {
+			r.front = r.hook(r.front).next
+		}
+	} else {
+		for n = r.size - n; n > 0; n-- {
+			r.front = r.hook(r.front).prev
+		}
+	}
+}
+
+// Move the cursor of Ring to the specified element without changing order of elements
+func (r *Ring[T]) RotateTo(element *T) {
+	r.verifyIsMemberOfCurrent(element)
+	r.front = element
+}
+
+// Move element to the cursor of Ring preserving order of other elements
+func (r *Ring[T]) MoveToFront(element *T) {
+	r.verifyIsMemberOfCurrent(element)
+	if element == r.front {
+		return
+	}
+	defer r.verifyLinks()
+	defer r.verifySize()
+
+	r.unlink(element)
+	r.link(r.front, element)
+	r.front = element
+}
+
+// Move element right before the cursor of Ring preserving order of other elements
+func (r *Ring[T]) MoveToBack(element *T) {
+	r.verifyIsMemberOfCurrent(element)
+	if element == r.hook(r.front).prev {
+		return
+	}
+	defer r.verifyLinks()
+	defer r.verifySize()
+
+	if element == r.front {
+		// Moving front to back of a ring is just a single rotation
+		r.front = r.hook(element).next
+		return
+	}
+	r.unlink(element)
+	r.link(r.front, element)
+}
+
+// Apply f to each element of Ring exactly once starting at the cursor.
+//
+// Ring MUST NOT be modified by f
+func (r Ring[T]) Do(f func(*T)) {
+	if r.front == nil {
+		return
+	}
+	e := r.front
+	for i := 0; i < r.size; i++ {
+		next := r.hook(e).next
+		f(e)
+		e = next
+	}
+}
+
+// Clear Ring and return all currently linked elements as slice starting at the cursor.
+func (r *Ring[T]) Clear() (elements []*T) {
+	r.verifyLinks()
+
+	elements = make([]*T, 0, r.size)
+	e := r.front
+	for i := 0; i < r.size; i++ {
+		elements = append(elements, e)
+		h := r.hook(e)
+		e = h.next
+		h.Init()
+	}
+	r.Init()
+	return
+}
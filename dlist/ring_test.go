@@ -0,0 +1,379 @@
+package dlist
+
+import (
+	"testing"
+)
+
+func newEmbedRing() Ring[testEmbedItem] {
+	return NewRing(embedHook)
+}
+
+func newEmbedRingGenerate(count int, generator func(position int) int) (r Ring[testEmbedItem]) {
+	r = newEmbedRing()
+	for i := 0; i < count; i++ {
+		item := newEmbed(generator(i))
+		r.PushBack(&item)
+	}
+	return
+}
+
+func newMemberRing() Ring[testMemberItem] {
+	return NewRing(memberHook)
+}
+
+func newMemberRingGenerate(count int, generator func(position int) int) (r Ring[testMemberItem]) {
+	r = newMemberRing()
+	for i := 0; i < count; i++ {
+		item := newMember(generator(i))
+		r.PushBack(&item)
+	}
+	return
+}
+
+func ringEmbedValues(r Ring[testEmbedItem]) (values []int) {
+	r.Do(func(e *testEmbedItem) {
+		values = append(values, e.value)
+	})
+	return
+}
+
+func ringMemberValues(r Ring[testMemberItem]) (values []int) {
+	r.Do(func(e *testMemberItem) {
+		values = append(values, e.value)
+	})
+	return
+}
+
+func equalValues(lhs, rhs []int) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i := range lhs {
+		if lhs[i] != rhs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRingEmptyRingIsZeroSize(t *testing.T) {
+	e := newEmbedRing()
+	if size := e.Len(); size != 0 || !e.Empty() {
+		t.Errorf("new embedded element ring has size not equal to 0: %v", size)
+	}
+	if f, b := e.Front(), e.Back(); f != nil || b != nil {
+		t.Errorf("new embedded element ring has front %p or back %p", f, b)
+	}
+
+	m := newMemberRing()
+	if size := m.Len(); size != 0 || !m.Empty() {
+		t.Errorf("new member element ring has size not equal to 0: %v", size)
+	}
+	if f, b := m.Front(), m.Back(); f != nil || b != nil {
+		t.Errorf("new member element ring has front %p or back %p", f, b)
+	}
+}
+
+func TestRingEmptyRingDoNotPop(t *testing.T) {
+	e := newEmbedRing()
+	if p := e.PopFront(); p != nil {
+		t.Errorf("empty embedded element ring pop front returned %p", p)
+	}
+	if p := e.PopBack(); p != nil {
+		t.Errorf("empty embedded element ring pop back returned %p", p)
+	}
+
+	m := newMemberRing()
+	if p := m.PopFront(); p != nil {
+		t.Errorf("empty member element ring pop front returned %p", p)
+	}
+	if p := m.PopBack(); p != nil {
+		t.Errorf("empty member element ring pop back returned %p", p)
+	}
+}
+
+func TestRingEmptyRingRotateAndClearDoNothing(t *testing.T) {
+	e := newEmbedRing()
+	e.Rotate(3)
+	if elements := e.Clear(); len(elements) != 0 {
+		t.Errorf("empty embedded element ring clear returned %v elements", len(elements))
+	}
+
+	m := newMemberRing()
+	m.Rotate(-3)
+	if elements := m.Clear(); len(elements) != 0 {
+		t.Errorf("empty member element ring clear returned %v elements", len(elements))
+	}
+}
+
+func TestRingOneElementRingLinksToItself(t *testing.T) {
+	e := newEmbedRing()
+	el := newEmbed(0)
+	e.PushFront(&el)
+	if el.Next() != &el || el.Prev() != &el {
+		t.Errorf("embedded element ring single element is not linked to itself")
+	}
+	if e.Front() != &el || e.Back() != &el {
+		t.Errorf("embedded element ring single element is not front and back")
+	}
+
+	m := newMemberRing()
+	ml := newMember(0)
+	m.PushBack(&ml)
+	if ml.hook.Next() != &ml || ml.hook.Prev() != &ml {
+		t.Errorf("member element ring single element is not linked to itself")
+	}
+	if m.Front() != &ml || m.Back() != &ml {
+		t.Errorf("member element ring single element is not front and back")
+	}
+}
+
+func TestRingOneElementRingPopMakesRingEmpty(t *testing.T) {
+	e := newEmbedRingGenerate(1, increment(0))
+	el := e.Front()
+	if p := e.PopBack(); p != el {
+		t.Errorf("embedded element ring pop back returned %p instead of %p", p, el)
+	}
+	if !e.Empty() || e.Front() != nil {
+		t.Errorf("embedded element ring is not empty after pop")
+	}
+	if el.Next() != nil || el.Prev() != nil {
+		t.Errorf("embedded element is still linked after pop")
+	}
+
+	m := newMemberRingGenerate(1, increment(0))
+	ml := m.Front()
+	if p := m.PopFront(); p != ml {
+		t.Errorf("member element ring pop front returned %p instead of %p", p, ml)
+	}
+	if !m.Empty() || m.Front() != nil {
+		t.Errorf("member element ring is not empty after pop")
+	}
+	if ml.hook.Next() != nil || ml.hook.Prev() != nil {
+		t.Errorf("member element is still linked after pop")
+	}
+}
+
+func TestRingIterationWraps(t *testing.T) {
+	e := newEmbedRingGenerate(3, increment(0))
+	if e.Next(e.Back()) != e.Front() {
+		t.Errorf("embedded element ring next of back is not front")
+	}
+	if e.Prev(e.Front()) != e.Back() {
+		t.Errorf("embedded element ring prev of front is not back")
+	}
+	if v := ringEmbedValues(e); !equalValues(v, []int{0, 1, 2}) {
+		t.Errorf("embedded element ring has unexpected order %v", v)
+	}
+
+	m := newMemberRingGenerate(3, increment(0))
+	if m.Next(m.Back()) != m.Front() {
+		t.Errorf("member element ring next of back is not front")
+	}
+	if m.Prev(m.Front()) != m.Back() {
+		t.Errorf("member element ring prev of front is not back")
+	}
+	if v := ringMemberValues(m); !equalValues(v, []int{0, 1, 2}) {
+		t.Errorf("member element ring has unexpected order %v", v)
+	}
+}
+
+func TestRingPushFrontMovesCursor(t *testing.T) {
+	e := newEmbedRingGenerate(2, increment(0))
+	el := newEmbed(5)
+	e.PushFront(&el)
+	if v := ringEmbedValues(e); !equalValues(v, []int{5, 0, 1}) {
+		t.Errorf("embedded element ring push front has unexpected order %v", v)
+	}
+
+	m := newMemberRingGenerate(2, increment(0))
+	ml := newMember(5)
+	m.PushFront(&ml)
+	if v := ringMemberValues(m); !equalValues(v, []int{5, 0, 1}) {
+		t.Errorf("member element ring push front has unexpected order %v", v)
+	}
+}
+
+func TestRingInsertAndErase(t *testing.T) {
+	e := newEmbedRingGenerate(3, increment(0))
+	el := newEmbed(5)
+	e.Insert(e.Next(e.Front()), &el)
+	if v := ringEmbedValues(e); !equalValues(v, []int{0, 5, 1, 2}) {
+		t.Errorf("embedded element ring insert has unexpected order %v", v)
+	}
+	e.Erase(e.Front())
+	if v := ringEmbedValues(e); !equalValues(v, []int{5, 1, 2}) {
+		t.Errorf("embedded element ring erase of front has unexpected order %v", v)
+	}
+	e.Insert(nil, &testEmbedItem{value: 7})
+	if v := ringEmbedValues(e); !equalValues(v, []int{5, 1, 2, 7}) {
+		t.Errorf("embedded element ring insert before nil has unexpected order %v", v)
+	}
+
+	m := newMemberRingGenerate(3, increment(0))
+	ml := newMember(5)
+	m.Insert(m.Back(), &ml)
+	if v := ringMemberValues(m); !equalValues(v, []int{0, 1, 5, 2}) {
+		t.Errorf("member element ring insert has unexpected order %v", v)
+	}
+	m.Erase(&ml)
+	if v := ringMemberValues(m); !equalValues(v, []int{0, 1, 2}) {
+		t.Errorf("member element ring erase has unexpected order %v", v)
+	}
+}
+
+func TestRingRotate(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected []int
+	}{
+		{0, []int{0, 1, 2, 3, 4}},
+		{1, []int{1, 2, 3, 4, 0}},
+		{4, []int{4, 0, 1, 2, 3}},
+		{5, []int{0, 1, 2, 3, 4}},
+		{7, []int{2, 3, 4, 0, 1}},
+		{-1, []int{4, 0, 1, 2, 3}},
+		{-6, []int{4, 0, 1, 2, 3}},
+	}
+	for _, test := range tests {
+		e := newEmbedRingGenerate(5, increment(0))
+		e.Rotate(test.n)
+		if v := ringEmbedValues(e); !equalValues(v, test.expected) {
+			t.Errorf("embedded element ring rotate by %d: expected %v, got %v", test.n, test.expected, v)
+		}
+
+		m := newMemberRingGenerate(5, increment(0))
+		m.Rotate(test.n)
+		if v := ringMemberValues(m); !equalValues(v, test.expected) {
+			t.Errorf("member element ring rotate by %d: expected %v, got %v", test.n, test.expected, v)
+		}
+	}
+}
+
+func TestRingRoundRobin(t *testing.T) {
+	e := newEmbedRingGenerate(3, increment(0))
+	visited := make([]int, 0, 7)
+	for i := 0; i < 7; i++ {
+		visited = append(visited, e.Front().value)
+		e.Rotate(1)
+	}
+	if !equalValues(visited, []int{0, 1, 2, 0, 1, 2, 0}) {
+		t.Errorf("embedded element ring round robin visited %v", visited)
+	}
+	if e.Len() != 3 {
+		t.Errorf("embedded element ring round robin changed size %v", e.Len())
+	}
+}
+
+func TestRingRotateTo(t *testing.T) {
+	e := newEmbedRingGenerate(4, increment(0))
+	e.RotateTo(e.Back())
+	if v := ringEmbedValues(e); !equalValues(v, []int{3, 0, 1, 2}) {
+		t.Errorf("embedded element ring rotate to has unexpected order %v", v)
+	}
+
+	m := newMemberRingGenerate(4, increment(0))
+	m.RotateTo(m.Next(m.Front()))
+	if v := ringMemberValues(m); !equalValues(v, []int{1, 2, 3, 0}) {
+		t.Errorf("member element ring rotate to has unexpected order %v", v)
+	}
+}
+
+func TestRingMoveToFront(t *testing.T) {
+	e := newEmbedRingGenerate(4, increment(0))
+	e.MoveToFront(e.Next(e.Next(e.Front())))
+	if v := ringEmbedValues(e); !equalValues(v, []int{2, 0, 1, 3}) {
+		t.Errorf("embedded element ring move to front has unexpected order %v", v)
+	}
+	e.MoveToFront(e.Front())
+	if v := ringEmbedValues(e); !equalValues(v, []int{2, 0, 1, 3}) {
+		t.Errorf("embedded element ring move front to front has unexpected order %v", v)
+	}
+
+	m := newMemberRingGenerate(4, increment(0))
+	m.MoveToFront(m.Back())
+	if v := ringMemberValues(m); !equalValues(v, []int{3, 0, 1, 2}) {
+		t.Errorf("member element ring move to front has unexpected order %v", v)
+	}
+}
+
+func TestRingMoveToBack(t *testing.T) {
+	e := newEmbedRingGenerate(4, increment(0))
+	e.MoveToBack(e.Next(e.Front()))
+	if v := ringEmbedValues(e); !equalValues(v, []int{0, 2, 3, 1}) {
+		t.Errorf("embedded element ring move to back has unexpected order %v", v)
+	}
+	e.MoveToBack(e.Front())
+	if v := ringEmbedValues(e); !equalValues(v, []int{2, 3, 1, 0}) {
+		t.Errorf("embedded element ring move front to back has unexpected order %v", v)
+	}
+	e.MoveToBack(e.Back())
+	if v := ringEmbedValues(e); !equalValues(v, []int{2, 3, 1, 0}) {
+		t.Errorf("embedded element ring move back to back has unexpected order %v", v)
+	}
+
+	m := newMemberRingGenerate(4, increment(0))
+	m.MoveToBack(m.Next(m.Front()))
+	if v := ringMemberValues(m); !equalValues(v, []int{0, 2, 3, 1}) {
+		t.Errorf("member element ring move to back has unexpected order %v", v)
+	}
+}
+
+func TestRingPopBackReturnsBack(t *testing.T) {
+	e := newEmbedRingGenerate(3, increment(0))
+	if p := e.PopBack(); p.value != 2 {
+		t.Errorf("embedded element ring pop back returned %v", p.value)
+	}
+	if v := ringEmbedValues(e); !equalValues(v, []int{0, 1}) {
+		t.Errorf("embedded element ring pop back has unexpected order %v", v)
+	}
+
+	m := newMemberRingGenerate(3, increment(0))
+	if p := m.PopFront(); p.value != 0 {
+		t.Errorf("member element ring pop front returned %v", p.value)
+	}
+	if v := ringMemberValues(m); !equalValues(v, []int{1, 2}) {
+		t.Errorf("member element ring pop front has unexpected order %v", v)
+	}
+}
+
+func TestRingClearReturnsAllElementsUnlinked(t *testing.T) {
+	e := newEmbedRingGenerate(3, increment(0))
+	e.Rotate(1)
+	elements := e.Clear()
+	if len(elements) != 3 || !e.Empty() || e.Front() != nil {
+		t.Errorf("embedded element ring clear returned %v elements", len(elements))
+	}
+	for i, el := range elements {
+		if el.value != (i+1)%3 {
+			t.Errorf("embedded element ring clear returned %v at %v", el.value, i)
+		}
+		if el.Next() != nil || el.Prev() != nil {
+			t.Errorf("embedded element ring clear returned linked element %v", el.value)
+		}
+	}
+
+	m := newMemberRingGenerate(3, increment(0))
+	if elements := m.Clear(); len(elements) != 3 || !m.Empty() {
+		t.Errorf("member element ring clear returned %v elements", len(elements))
+	}
+}
+
+func TestRingSwap(t *testing.T) {
+	e1 := newEmbedRingGenerate(3, increment(0))
+	e2 := newEmbedRingGenerate(1, increment(10))
+	e1.Swap(&e2)
+	if v := ringEmbedValues(e1); !equalValues(v, []int{10}) {
+		t.Errorf("embedded element ring swap has unexpected content %v", v)
+	}
+	if v := ringEmbedValues(e2); !equalValues(v, []int{0, 1, 2}) {
+		t.Errorf("embedded element ring swap has unexpected content %v", v)
+	}
+
+	m1 := newMemberRingGenerate(3, increment(0))
+	m2 := newMemberRing()
+	m1.Swap(&m2)
+	if !m1.Empty() || m2.Len() != 3 {
+		t.Errorf("member element ring swap has unexpected sizes %v %v", m1.Len(), m2.Len())
+	}
+}
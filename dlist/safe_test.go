@@ -0,0 +1,104 @@
+package dlist
+
+import (
+	"errors"
+	"testing"
+)
+
+func expectPanic(t *testing.T, target error, f func()) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, target) {
+			t.Errorf("expected panic with %v got %v", target, r)
+		}
+	}()
+	f()
+}
+
+func TestSafeDListDoubleInsertPanics(t *testing.T) {
+	l := newAutoListGenerate(2, increment(0))
+	other := newAutoListGenerate(1, increment(10))
+	e := l.Front()
+
+	expectPanic(t, ErrAlreadyLinked, func() { l.PushBack(e) })
+	expectPanic(t, ErrAlreadyLinked, func() { l.PushFront(e) })
+	expectPanic(t, ErrAlreadyLinked, func() { other.Insert(other.Front(), e) })
+	if l.Len() != 2 || other.Len() != 1 || e.Owner() != l {
+		t.Errorf("failed insertion changed lists %v %v", l.Len(), other.Len())
+	}
+}
+
+func TestSafeDListForeignElementPanics(t *testing.T) {
+	l := newAutoListGenerate(2, increment(0))
+	other := newAutoListGenerate(2, increment(10))
+	free := testAutoItem{value: 20}
+
+	expectPanic(t, ErrNotMember, func() { l.Erase(other.Front()) })
+	expectPanic(t, ErrNotMember, func() { l.Erase(&free) })
+	expectPanic(t, ErrNotMember, func() { l.Insert(other.Front(), &free) })
+	expectPanic(t, ErrNotMember, func() { l.Splice(other.Front(), newAutoListGenerate(1, increment(30))) })
+	expectPanic(t, ErrNotMember, func() { l.MoveAfter(l.Front(), other.Front()) })
+	expectPanic(t, ErrNotMember, func() { l.SpliceRange(nil, other, l.Front(), l.Back()) })
+	if l.Len() != 2 || other.Len() != 2 || free.IsLinked() {
+		t.Errorf("failed operation changed lists %v %v", l.Len(), other.Len())
+	}
+}
+
+func TestSafeDListForeignSourcePanics(t *testing.T) {
+	l := newAutoListGenerate(2, increment(0))
+	other := newAutoListGenerate(2, increment(10))
+	copied := *other
+	plain := New(func(self *testAutoItem) *Hook[testAutoItem] { return &self.AutoUnlinkHook.Hook })
+	plain.PushBack(&testAutoItem{value: 20})
+	less := func(lhs, rhs *testAutoItem) bool { return lhs.value < rhs.value }
+
+	expectPanic(t, ErrNotMember, func() { l.Splice(l.Front(), &copied) })
+	expectPanic(t, ErrNotMember, func() { l.SpliceBack(&copied) })
+	expectPanic(t, ErrNotMember, func() { l.Merge(&copied, less) })
+	expectPanic(t, ErrModeMismatch, func() { l.Splice(nil, &plain) })
+	expectPanic(t, ErrModeMismatch, func() { plain.SpliceFront(l) })
+	expectPanic(t, ErrModeMismatch, func() { l.SpliceRange(nil, &plain, plain.Front(), plain.Back()) })
+	expectPanic(t, ErrModeMismatch, func() { plain.SpliceRange(nil, l, l.Front(), l.Back()) })
+	expectPanic(t, ErrModeMismatch, func() { l.Merge(&plain, less) })
+	expectPanic(t, ErrModeMismatch, func() { plain.Merge(l, less) })
+	if l.Len() != 2 || other.Len() != 2 || plain.Len() != 1 {
+		t.Errorf("failed operation changed lists %v %v %v", l.Len(), other.Len(), plain.Len())
+	}
+	for e := l.Front(); e != nil; e = e.Next() {
+		if e.Owner() != l {
+			t.Errorf("element %v has owner %p instead of %p", e.value, e.Owner(), l)
+		}
+	}
+}
+
+func TestSafeDListIsLinked(t *testing.T) {
+	l := NewAutoUnlink(autoHook)
+	e := testAutoItem{AutoUnlinkHook: NewAutoUnlinkHook[testAutoItem](), value: 0}
+	if e.IsLinked() {
+		t.Errorf("new element is linked")
+	}
+	l.PushBack(&e)
+	if !e.IsLinked() || e.Owner() != &l {
+		t.Errorf("pushed element is not linked")
+	}
+	l.PopFront()
+	if e.IsLinked() {
+		t.Errorf("popped element is linked")
+	}
+	l.PushFront(&e)
+	if !e.IsLinked() {
+		t.Errorf("element is not linked after reinsertion")
+	}
+}
+
+func TestUnsafeDListDoNotTrackOwner(t *testing.T) {
+	l := newEmbedListGenerate(2, increment(0))
+	if l.ownerFunc != nil {
+		t.Errorf("DList created with New tracks owner")
+	}
+	l.Erase(l.Front())
+	if l.Len() != 1 {
+		t.Errorf("unexpected size %v", l.Len())
+	}
+}
@@ -0,0 +1,72 @@
+package dlist
+
+import (
+	"github.com/echo-Mike/intrusive/internal/pkg/guard"
+)
+
+type (
+	// DList guarded by read-write mutex that can be shared between goroutines.
+	//
+	// Hooks of linked elements are modified only under write lock, so elements
+	// MUST NOT be accessed through their hooks without holding the lock (see Do and View).
+	// Len and Empty don't take the lock.
+	// SyncDList MUST NOT be copied after first use
+	SyncDList[T any] struct {
+		guard.Guarded[*DList[T]]
+		list DList[T]
+	}
+)
+
+// Create new SyncDList taking ownership of list. List SHOULD be empty as elements
+// of list created with NewAutoUnlink store the address of its head
+func NewSync[T any](list DList[T]) *SyncDList[T] {
+	s := &SyncDList[T]{list: list}
+	s.list.setOwner(s.list.first, s.list.last, &s.list)
+	guard.Init(&s.Guarded, &s.list)
+	return s
+}
+
+// Return first element in DList
+func (s *SyncDList[T]) Front() (front *T) {
+	s.View(func(list *DList[T]) { front = list.Front() })
+	return
+}
+
+// Return last element in DList
+func (s *SyncDList[T]) Back() (back *T) {
+	s.View(func(list *DList[T]) { back = list.Back() })
+	return
+}
+
+// Insert new element at the front of DList
+func (s *SyncDList[T]) PushFront(element *T) {
+	s.Do(func(list *DList[T]) { list.PushFront(element) })
+}
+
+// Insert new element at the back of DList
+func (s *SyncDList[T]) PushBack(element *T) {
+	s.Do(func(list *DList[T]) { list.PushBack(element) })
+}
+
+// Unlink and return first element of DList or nil if DList is empty
+func (s *SyncDList[T]) PopFront() (popped *T) {
+	s.Do(func(list *DList[T]) { popped = list.PopFront() })
+	return
+}
+
+// Unlink and return last element of DList or nil if DList is empty
+func (s *SyncDList[T]) PopBack() (popped *T) {
+	s.Do(func(list *DList[T]) { popped = list.PopBack() })
+	return
+}
+
+// Unlink element from DList. Element SHOULD be part of DList
+func (s *SyncDList[T]) Erase(element *T) {
+	s.Do(func(list *DList[T]) { list.Erase(element) })
+}
+
+// Remove all elements from DList and return them as slice
+func (s *SyncDList[T]) Clear() (elements []*T) {
+	s.Do(func(list *DList[T]) { elements = list.Clear() })
+	return
+}
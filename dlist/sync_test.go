@@ -0,0 +1,139 @@
+package dlist
+
+import (
+	"sync"
+	"testing"
+)
+
+func newSyncItems(count int) []testEmbedItem {
+	items := make([]testEmbedItem, count)
+	for i := range items {
+		items[i] = newEmbed(i)
+	}
+	return items
+}
+
+func TestSyncDListConcurrentPushPop(t *testing.T) {
+	const workers = 8
+	const perWorker = 200
+	items := newSyncItems(workers * perWorker)
+	s := NewSync(newEmbedList())
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w * perWorker; i < (w+1)*perWorker; i++ {
+				if i%2 == 0 {
+					s.PushBack(&items[i])
+				} else {
+					s.PushFront(&items[i])
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	if s.Len() != len(items) {
+		t.Fatalf("list has size %v after concurrent push instead of %v", s.Len(), len(items))
+	}
+
+	popped := make([][]*testEmbedItem, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for {
+				var e *testEmbedItem
+				if w%2 == 0 {
+					e = s.PopFront()
+				} else {
+					e = s.PopBack()
+				}
+				if e == nil {
+					return
+				}
+				popped[w] = append(popped[w], e)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	seen := make([]bool, len(items))
+	for _, p := range popped {
+		for _, e := range p {
+			if seen[e.value] {
+				t.Fatalf("element %v is popped twice", e.value)
+			}
+			seen[e.value] = true
+		}
+	}
+	for i, ok := range seen {
+		if !ok {
+			t.Errorf("element %v is not popped", i)
+		}
+	}
+	if !s.Empty() || s.Front() != nil || s.Back() != nil {
+		t.Errorf("list is not empty after all elements are popped")
+	}
+}
+
+func TestSyncDListDoAndView(t *testing.T) {
+	const rotations = 1000
+	items := newSyncItems(64)
+	s := NewSync(newEmbedList())
+	for i := range items {
+		s.PushBack(&items[i])
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rotations; i++ {
+				s.Do(func(l *DList[testEmbedItem]) {
+					e := l.PopFront()
+					l.PushBack(e)
+				})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rotations; i++ {
+				s.View(func(l *DList[testEmbedItem]) {
+					if err := l.Validate(); err != nil {
+						t.Errorf("list is invalid during view: %v", err)
+					}
+					if l.Len() != len(items) {
+						t.Errorf("view observed intermediate size %v", l.Len())
+					}
+				})
+				if s.Len() != len(items) {
+					t.Errorf("Len observed intermediate size %v", s.Len())
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSyncDListEraseAndClear(t *testing.T) {
+	s := NewSync(NewAutoUnlink(autoHook))
+	autoItems := make([]testAutoItem, 10)
+	for i := range autoItems {
+		s.PushBack(&autoItems[i])
+	}
+	s.Erase(&autoItems[3])
+	if s.Len() != 9 || autoItems[3].IsLinked() {
+		t.Errorf("erase from synchronized list failed")
+	}
+	s.View(func(l *DList[testAutoItem]) {
+		if autoItems[0].Owner() != l {
+			t.Errorf("element is not owned by synchronized list")
+		}
+	})
+	if elements := s.Clear(); len(elements) != 9 || s.Len() != 0 || autoItems[0].IsLinked() {
+		t.Errorf("clear of synchronized list failed")
+	}
+}
@@ -0,0 +1,48 @@
+package dlist
+
+// Check integrity of DList and return *ValidationError describing the first detected violation
+// or nil if DList is consistent. Unlike debug verifiers it is available in all builds.
+//
+// Complexity is linear in size of DList
+func (d *DList[T]) Validate() error {
+	if cycle := d.findCycle(); cycle != nil {
+		return &ValidationError[T]{Err: ErrCycle, Element: cycle}
+	}
+
+	count := 0
+	var prev *T = nil
+	for e := d.first; e != nil; e = d.hook(e).next {
+		if d.hook(e).prev != prev {
+			return &ValidationError[T]{Err: ErrBrokenLink, Element: e}
+		}
+		if d.ownerFunc != nil && *d.ownerFunc(e) != d {
+			return &ValidationError[T]{Err: ErrNotMember, Element: e}
+		}
+		prev = e
+		count++
+	}
+	if d.last != prev {
+		return &ValidationError[T]{Err: ErrBrokenLink, Element: d.last}
+	}
+	if count != d.size {
+		return &ValidationError[T]{Err: ErrSizeMismatch, Element: nil}
+	}
+	return nil
+}
+
+// Find cycle in forward links of DList using Floyd's algorithm.
+// Return element inside of the cycle or nil if there is no cycle
+func (d *DList[T]) findCycle() *T {
+	slow, fast := d.first, d.first
+	for fast != nil {
+		if fast = d.hook(fast).next; fast == nil {
+			return nil
+		}
+		fast = d.hook(fast).next
+		slow = d.hook(slow).next
+		if fast == slow {
+			return fast
+		}
+	}
+	return nil
+}
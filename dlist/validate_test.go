@@ -0,0 +1,74 @@
+package dlist
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDListValidateValidList(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 5} {
+		e := newEmbedListGenerate(size, increment(0))
+		if err := e.Validate(); err != nil {
+			t.Errorf("embedded element list of size %v is invalid: %v", size, err)
+		}
+		m := newMemberListGenerate(size, increment(0))
+		if err := m.Validate(); err != nil {
+			t.Errorf("member element list of size %v is invalid: %v", size, err)
+		}
+	}
+	a := newAutoListGenerate(3, increment(0))
+	if err := a.Validate(); err != nil {
+		t.Errorf("auto-unlink list is invalid: %v", err)
+	}
+}
+
+func TestDListValidateDetectsCorruption(t *testing.T) {
+	tests := map[string]struct {
+		corrupt func(l *DList[testEmbedItem]) *testEmbedItem
+		err     error
+	}{
+		"cycle": {func(l *DList[testEmbedItem]) *testEmbedItem {
+			l.Back().next = l.Front()
+			return nil
+		}, ErrCycle},
+		"prev": {func(l *DList[testEmbedItem]) *testEmbedItem {
+			second := l.Front().Next()
+			second.prev = nil
+			return second
+		}, ErrBrokenLink},
+		"last": {func(l *DList[testEmbedItem]) *testEmbedItem {
+			l.last = l.Front()
+			return l.Front()
+		}, ErrBrokenLink},
+		"size": {func(l *DList[testEmbedItem]) *testEmbedItem {
+			l.size++
+			return nil
+		}, ErrSizeMismatch},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := newEmbedListGenerate(3, increment(0))
+			element := testCase.corrupt(&l)
+			err := l.Validate()
+			if !errors.Is(err, testCase.err) {
+				t.Fatalf("expected %v got %v", testCase.err, err)
+			}
+			var validationErr *ValidationError[testEmbedItem]
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("error is not ValidationError %v", err)
+			}
+			if element != nil && validationErr.Element != element {
+				t.Errorf("unexpected offending element %p instead of %p", validationErr.Element, element)
+			}
+		})
+	}
+}
+
+func TestDListValidateDetectsForeignElement(t *testing.T) {
+	a := newAutoListGenerate(2, increment(0))
+	b := newAutoListGenerate(1, increment(10))
+	b.Front().owner = a
+	if err := b.Validate(); !errors.Is(err, ErrNotMember) {
+		t.Errorf("expected %v got %v", ErrNotMember, err)
+	}
+}
@@ -13,14 +13,14 @@ func (d *DList[T]) verifyNotEmpty() {
 }
 
 func (d *DList[T]) verifyElementNotLinked(element *T) {
-	hook := d.hookFunc(element)
+	hook := d.hook(element)
 	if hook.next != nil || hook.prev != nil {
 		panic(fmt.Sprintf("already linked element detected: DList %p element: %p", d, element))
 	}
 }
 
 func (d *DList[T]) verifyIsMemberOfCurrent(element *T) {
-	for e := d.first; e != nil; e = d.hookFunc(e).next {
+	for e := d.first; e != nil; e = d.hook(e).next {
 		if e == element {
 			return
 		}
@@ -30,7 +30,7 @@ func (d *DList[T]) verifyIsMemberOfCurrent(element *T) {
 
 func (d *DList[T]) verifySize() {
 	count := 0
-	for e := d.first; e != nil; e = d.hookFunc(e).next {
+	for e := d.first; e != nil; e = d.hook(e).next {
 		count++
 		if count > d.size {
 			panic(fmt.Sprintf("size of list is greater than expected: DList %p", d))
@@ -43,7 +43,7 @@ func (d *DList[T]) verifySize() {
 
 func (d *DList[T]) verifyNoCycle() {
 	visited := make(map[*T]bool)
-	for e := d.first; e != nil; e = d.hookFunc(e).next {
+	for e := d.first; e != nil; e = d.hook(e).next {
 		if visited[e] {
 			panic(fmt.Sprintf("found a cycle: DList %p", d))
 		}
@@ -51,10 +51,93 @@ func (d *DList[T]) verifyNoCycle() {
 	}
 
 	visited = make(map[*T]bool)
-	for e := d.last; e != nil; e = d.hookFunc(e).prev {
+	for e := d.last; e != nil; e = d.hook(e).prev {
 		if visited[e] {
 			panic(fmt.Sprintf("found a cycle: DList %p", d))
 		}
 		visited[e] = true
 	}
-}
\ No newline at end of file
+}
+
+func (d *DList[T]) verifyIsRangeOfCurrent(first, last *T) {
+	d.verifyIsMemberOfCurrent(first)
+	for e := first; e != nil; e = d.hook(e).next {
+		if e == last {
+			return
+		}
+	}
+	panic(fmt.Sprintf("invalid range detected: DList %p first: %p last: %p", d, first, last))
+}
+
+func (d *DList[T]) verifyNotInRange(first, last, element *T) {
+	if element == nil {
+		return
+	}
+	d.verifyIsMemberOfCurrent(element)
+	for e := first; e != last; {
+		if e = d.hook(e).next; e == element {
+			panic(fmt.Sprintf("element inside of range detected: DList %p element: %p", d, element))
+		}
+	}
+}
+
+func (r *Ring[T]) verifyNotEmpty() {
+	if r.front == nil || r.size == 0 {
+		panic(fmt.Sprintf("unexpected empty ring: Ring %p", r))
+	}
+}
+
+func (r *Ring[T]) verifyElementNotLinked(element *T) {
+	hook := r.hook(element)
+	if hook.next != nil || hook.prev != nil {
+		panic(fmt.Sprintf("already linked element detected: Ring %p element: %p", r, element))
+	}
+}
+
+func (r *Ring[T]) verifyIsMemberOfCurrent(element *T) {
+	e := r.front
+	for i := 0; i < r.size; i++ {
+		if e == element {
+			return
+		}
+		e = r.hook(e).next
+	}
+	panic(fmt.Sprintf("not member of detected: Ring %p element: %p", r, element))
+}
+
+func (r *Ring[T]) verifySize() {
+	if r.front == nil {
+		if r.size != 0 {
+			panic(fmt.Sprintf("size mismatch: expected %d, got 0: Ring %p", r.size, r))
+		}
+		return
+	}
+	count := 0
+	e := r.front
+	for {
+		count++
+		if count > r.size {
+			panic(fmt.Sprintf("size of ring is greater than expected: Ring %p", r))
+		}
+		if e = r.hook(e).next; e == r.front {
+			break
+		}
+	}
+	if count != r.size {
+		panic(fmt.Sprintf("size mismatch: expected %d, got %d: Ring %p", r.size, count, r))
+	}
+}
+
+func (r *Ring[T]) verifyLinks() {
+	e := r.front
+	for i := 0; i < r.size; i++ {
+		next := r.hook(e).next
+		if next == nil || r.hook(next).prev != e {
+			panic(fmt.Sprintf("broken link detected: Ring %p element: %p", r, e))
+		}
+		e = next
+	}
+	if e != r.front {
+		panic(fmt.Sprintf("ring is not closed: Ring %p", r))
+	}
+}
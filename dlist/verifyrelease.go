@@ -16,3 +16,24 @@ func (d *DList[T]) verifySize() {
 
 func (d *DList[T]) verifyNoCycle() {
 }
+
+func (d *DList[T]) verifyIsRangeOfCurrent(first, last *T) {
+}
+
+func (d *DList[T]) verifyNotInRange(first, last, element *T) {
+}
+
+func (r *Ring[T]) verifyNotEmpty() {
+}
+
+func (r *Ring[T]) verifyElementNotLinked(element *T) {
+}
+
+func (r *Ring[T]) verifyIsMemberOfCurrent(element *T) {
+}
+
+func (r *Ring[T]) verifySize() {
+}
+
+func (r *Ring[T]) verifyLinks() {
+}
@@ -0,0 +1,185 @@
+package graph
+
+// Start a new run of an algorithm: all nodes become not visited
+func (g *Graph[N, E]) newEpoch() {
+	g.epoch++
+}
+
+func (g *Graph[N, E]) visited(node *N) bool {
+	return g.nodeHookFunc(node).epoch == g.epoch
+}
+
+// Mark node as visited in current run and reset its state
+func (g *Graph[N, E]) discover(node, parent *N) *NodeHook[N, E] {
+	hook := g.nodeHookFunc(node)
+	hook.epoch = g.epoch
+	hook.parent = parent
+	hook.link = nil
+	hook.cursor = hook.out.Front()
+	hook.index, hook.low = 0, 0
+	hook.onStack = false
+	return hook
+}
+
+// Walk nodes reachable from start in depth-first order without recursion. Nodes visited
+// in current run are skipped. Enter is called when node is discovered, seen is called for
+// every edge to already visited node and leave is called when all edges of node are explored.
+// Walk stops and returns false if enter or seen returns false
+func (g *Graph[N, E]) walk(start *N, enter func(*N) bool, seen func(from, to *N) bool, leave func(*N)) bool {
+	if g.visited(start) {
+		return true
+	}
+	g.discover(start, nil)
+	if !enter(start) {
+		return false
+	}
+	for node := start; node != nil; {
+		hook := g.nodeHookFunc(node)
+		if edge := hook.cursor; edge != nil {
+			hook.cursor = hook.out.Next(edge)
+			to := g.edgeHookFunc(edge).to
+			if !g.visited(to) {
+				g.discover(to, node)
+				if !enter(to) {
+					return false
+				}
+				node = to
+			} else if !seen(node, to) {
+				return false
+			}
+			continue
+		}
+		leave(node)
+		node = hook.parent
+	}
+	return true
+}
+
+// DFS calls visit for every node reachable from start in depth-first pre-order.
+// Traversal stops when visit returns false
+func (g *Graph[N, E]) DFS(start *N, visit func(*N) bool) {
+	g.verifyIsMemberOfCurrent(start)
+	g.newEpoch()
+	g.walk(start, visit, func(from, to *N) bool { return true }, func(*N) {})
+}
+
+// BFS calls visit for every node reachable from start in breadth-first order.
+// Traversal stops when visit returns false
+func (g *Graph[N, E]) BFS(start *N, visit func(*N) bool) {
+	g.verifyIsMemberOfCurrent(start)
+	g.newEpoch()
+	// Queue of discovered nodes is linked through NodeHook
+	head := start
+	tail := g.discover(start, nil)
+	for head != nil {
+		node := head
+		head = g.nodeHookFunc(node).link
+		if !visit(node) {
+			return
+		}
+		out := &g.nodeHookFunc(node).out
+		for edge := out.Front(); edge != nil; edge = out.Next(edge) {
+			if to := g.edgeHookFunc(edge).to; !g.visited(to) {
+				hook := g.discover(to, node)
+				if head == nil {
+					head = to
+				} else {
+					tail.link = to
+				}
+				tail = hook
+			}
+		}
+	}
+}
+
+// Walk all nodes and call leave in depth-first post-order. Return false if graph has a cycle
+func (g *Graph[N, E]) postOrder(leave func(*N)) bool {
+	g.newEpoch()
+	enter := func(node *N) bool {
+		g.nodeHookFunc(node).onStack = true
+		return true
+	}
+	// Edge to a node on the current path closes a cycle
+	seen := func(from, to *N) bool {
+		return !g.nodeHookFunc(to).onStack
+	}
+	exit := func(node *N) {
+		g.nodeHookFunc(node).onStack = false
+		leave(node)
+	}
+	for node := range g.Nodes() {
+		if !g.walk(node, enter, seen, exit) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasCycle checks if graph has a directed cycle
+func (g *Graph[N, E]) HasCycle() bool {
+	return !g.postOrder(func(*N) {})
+}
+
+// TopologicalSort returns nodes ordered so that every edge goes from earlier node to later one.
+// Return false if graph has a cycle
+func (g *Graph[N, E]) TopologicalSort() ([]*N, bool) {
+	order := make([]*N, g.NodeCount())
+	i := len(order)
+	if !g.postOrder(func(node *N) {
+		i--
+		order[i] = node
+	}) {
+		return nil, false
+	}
+	return order, true
+}
+
+// StronglyConnectedComponents returns strongly connected components of graph in reverse
+// topological order: no edge goes from a component to a later one
+func (g *Graph[N, E]) StronglyConnectedComponents() (components [][]*N) {
+	g.newEpoch()
+	// Tarjan's algorithm, stack of nodes is linked through NodeHook
+	var stack *N
+	counter := 0
+	enter := func(node *N) bool {
+		hook := g.nodeHookFunc(node)
+		counter++
+		hook.index, hook.low = counter, counter
+		hook.link, stack = stack, node
+		hook.onStack = true
+		return true
+	}
+	seen := func(from, to *N) bool {
+		if toHook := g.nodeHookFunc(to); toHook.onStack {
+			fromHook := g.nodeHookFunc(from)
+			fromHook.low = min(fromHook.low, toHook.index)
+		}
+		return true
+	}
+	leave := func(node *N) {
+		hook := g.nodeHookFunc(node)
+		if hook.parent != nil {
+			parentHook := g.nodeHookFunc(hook.parent)
+			parentHook.low = min(parentHook.low, hook.low)
+		}
+		if hook.low != hook.index {
+			return
+		}
+		var component []*N
+		for {
+			top := stack
+			topHook := g.nodeHookFunc(top)
+			stack, topHook.link = topHook.link, nil
+			topHook.onStack = false
+			component = append(component, top)
+			if top == node {
+				break
+			}
+		}
+		components = append(components, component)
+	}
+	for node := range g.Nodes() {
+		g.walk(node, enter, seen, leave)
+	}
+	return
+}
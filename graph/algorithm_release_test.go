@@ -0,0 +1,46 @@
+//go:build !debug
+
+package graph
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Build chain of count nodes with edge back to the first node
+func newTestRing(count int) (*Graph[testNode, testEdge], []testNode) {
+	g := New(nodeHook, edgeHook)
+	nodes := make([]testNode, count)
+	edges := make([]testEdge, count)
+	for i := range nodes {
+		g.AddNode(&nodes[i])
+	}
+	for i := range edges {
+		g.AddEdge(&edges[i], &nodes[i], &nodes[(i+1)%count])
+	}
+	return &g, nodes
+}
+
+func TestGraphAlgorithmsDoNotAllocatePerNode(t *testing.T) {
+	algorithms := map[string]func(g *Graph[testNode, testEdge], start *testNode){
+		"DFS": func(g *Graph[testNode, testEdge], start *testNode) {
+			g.DFS(start, func(*testNode) bool { return true })
+		},
+		"BFS": func(g *Graph[testNode, testEdge], start *testNode) {
+			g.BFS(start, func(*testNode) bool { return true })
+		},
+		"HasCycle": func(g *Graph[testNode, testEdge], start *testNode) { g.HasCycle() },
+	}
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			var allocs []float64
+			for _, count := range []int{10, 1000} {
+				g, nodes := newTestRing(count)
+				allocs = append(allocs, testing.AllocsPerRun(10, func() { algorithm(g, &nodes[0]) }))
+			}
+			if allocs[0] != allocs[1] {
+				t.Errorf("%s allocates per node: %s", name, fmt.Sprint(allocs))
+			}
+		})
+	}
+}
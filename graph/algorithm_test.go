@@ -0,0 +1,138 @@
+package graph
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+/// Traversal
+
+func TestGraphTraversal(t *testing.T) {
+	tests := map[string]struct {
+		names string
+		edges []string
+		start byte
+		stop  string
+		dfs   string
+		bfs   string
+	}{
+		"single":  {"a", nil, 'a', "", "a", "a"},
+		"chain":   {"abc", []string{"ab", "bc"}, 'a', "", "abc", "abc"},
+		"tree":    {"abcde", []string{"ab", "ac", "bd", "ce"}, 'a', "", "abdce", "abcde"},
+		"cycle":   {"abc", []string{"ab", "bc", "ca"}, 'b', "", "bca", "bca"},
+		"diamond": {"abcd", []string{"ab", "ac", "bd", "cd", "da"}, 'a', "", "abdc", "abcd"},
+		"partial": {"abcd", []string{"ba", "bc", "cd"}, 'c', "", "cd", "cd"},
+		"stopped": {"abcde", []string{"ab", "ac", "bd", "ce"}, 'a', "d", "abd", "abcd"},
+		"loop":    {"ab", []string{"aa", "ab"}, 'a', "", "ab", "ab"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, nodes, _ := newTestGraph(tt.names, tt.edges...)
+			collect := func(visited *string) func(*testNode) bool {
+				return func(n *testNode) bool {
+					*visited += n.name
+					return n.name != tt.stop
+				}
+			}
+			var dfs, bfs string
+			g.DFS(nodes[tt.start], collect(&dfs))
+			g.BFS(nodes[tt.start], collect(&bfs))
+			if dfs != tt.dfs || bfs != tt.bfs {
+				t.Errorf("DFS visited %q, want %q; BFS visited %q, want %q", dfs, tt.dfs, bfs, tt.bfs)
+			}
+		})
+	}
+}
+
+/// Cycles
+
+func TestGraphTopologicalSort(t *testing.T) {
+	tests := map[string]struct {
+		names string
+		edges []string
+		cycle bool
+	}{
+		"empty":        {"", nil, false},
+		"isolated":     {"abc", nil, false},
+		"chain":        {"cba", []string{"ab", "bc"}, false},
+		"diamond":      {"dcba", []string{"ab", "ac", "bd", "cd"}, false},
+		"forest":       {"abcdef", []string{"fa", "eb", "ca", "db"}, false},
+		"parallel":     {"ab", []string{"ab", "ab"}, false},
+		"loop":         {"ab", []string{"ab", "bb"}, true},
+		"cycle":        {"abcd", []string{"ab", "bc", "cd", "db"}, true},
+		"cycle behind": {"abcd", []string{"dc", "cb", "ba", "ac"}, true},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, _, edges := newTestGraph(tt.names, tt.edges...)
+			if g.HasCycle() != tt.cycle {
+				t.Errorf("HasCycle() = %v, want %v", !tt.cycle, tt.cycle)
+			}
+			order, ok := g.TopologicalSort()
+			if ok == tt.cycle || tt.cycle && order != nil {
+				t.Fatalf("TopologicalSort() = %v, %v", nodeNames(order), ok)
+			}
+			if tt.cycle {
+				return
+			}
+			names := nodeNames(order)
+			if len(names) != len(tt.names) {
+				t.Fatalf("order %q misses nodes of %q", names, tt.names)
+			}
+			for i := range edges {
+				from, to := g.From(&edges[i]).name, g.To(&edges[i]).name
+				if strings.Index(names, from) > strings.Index(names, to) {
+					t.Errorf("order %q violates edge %s%s", names, from, to)
+				}
+			}
+		})
+	}
+}
+
+func TestGraphStronglyConnectedComponents(t *testing.T) {
+	tests := map[string]struct {
+		names string
+		edges []string
+		want  []string
+	}{
+		"empty":    {"", nil, nil},
+		"isolated": {"ab", nil, []string{"a", "b"}},
+		"chain":    {"abc", []string{"ab", "bc"}, []string{"c", "b", "a"}},
+		"cycle":    {"abc", []string{"ab", "bc", "ca"}, []string{"abc"}},
+		"loop":     {"ab", []string{"aa", "ab"}, []string{"b", "a"}},
+		"two":      {"abcdef", []string{"ab", "ba", "bc", "cd", "de", "ec", "ef"}, []string{"f", "cde", "ab"}},
+		"classic": {"abcdefgh", []string{"ab", "bc", "be", "bf", "cd", "cg", "dc", "dh", "ea", "ef", "fg", "gf", "hd", "hg"},
+			[]string{"fg", "cdh", "abe"}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			g, _, _ := newTestGraph(tt.names, tt.edges...)
+			var got []string
+			for _, component := range g.StronglyConnectedComponents() {
+				names := []byte(nodeNames(component))
+				slices.Sort(names)
+				got = append(got, string(names))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("components %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphRepeatedRuns(t *testing.T) {
+	g, nodes, _ := newTestGraph("abc", "ab", "bc")
+	for range 3 {
+		var visited string
+		g.DFS(nodes['a'], func(n *testNode) bool { visited += n.name; return true })
+		if visited != "abc" || g.HasCycle() {
+			t.Fatalf("run visited %q", visited)
+		}
+	}
+	edge := testEdge{id: 2}
+	g.AddEdge(&edge, nodes['c'], nodes['a'])
+	if !g.HasCycle() || len(g.StronglyConnectedComponents()) != 1 {
+		t.Errorf("cycle added between runs is not detected")
+	}
+}
@@ -0,0 +1,194 @@
+// Package graph implements intrusive directed graph. Nodes embed NodeHook with
+// lists of out-edges and in-edges, edges embed EdgeHook that links an edge into
+// both lists, so edges are added and removed in constant time.
+//
+// Algorithms of a graph keep their state in NodeHook instead of maps keyed by
+// nodes: a node is visited in current run if its epoch equals epoch of the run.
+// Graph MUST NOT be modified while an algorithm is running, including from
+// callbacks of the algorithm
+package graph
+
+import (
+	"iter"
+
+	"github.com/echo-Mike/intrusive/dlist"
+)
+
+type (
+	// NodeHook structure to insert/embed into concrete types
+	// of nodes of a graph
+	NodeHook[N, E any] struct {
+		node    dlist.Hook[N]
+		out, in dlist.DList[E]
+		owner   *Graph[N, E]
+		// State of algorithms, valid if epoch equals epoch of graph
+		epoch      uint64
+		parent     *N
+		link       *N
+		cursor     *E
+		index, low int
+		onStack    bool
+	}
+
+	// EdgeHook structure to insert/embed into concrete types
+	// of edges of a graph
+	EdgeHook[N, E any] struct {
+		out, in  dlist.Hook[E]
+		from, to *N
+	}
+
+	// Graph implements directed graph of intrusive nodes and edges.
+	// Parallel edges and loops are allowed
+	Graph[N, E any] struct {
+		nodeHookFunc func(*N) *NodeHook[N, E]
+		edgeHookFunc func(*E) *EdgeHook[N, E]
+		outHookFunc  func(*E) *dlist.Hook[E]
+		inHookFunc   func(*E) *dlist.Hook[E]
+		nodes        dlist.DList[N]
+		edges        int
+		epoch        uint64
+	}
+)
+
+// NewNodeHook creates a new initialized NodeHook
+func NewNodeHook[N, E any]() NodeHook[N, E] {
+	return NodeHook[N, E]{}
+}
+
+// NewEdgeHook creates a new initialized EdgeHook
+func NewEdgeHook[N, E any]() EdgeHook[N, E] {
+	return EdgeHook[N, E]{}
+}
+
+// New creates a new empty Graph
+func New[N, E any](nodeHookFunc func(*N) *NodeHook[N, E], edgeHookFunc func(*E) *EdgeHook[N, E]) Graph[N, E] {
+	return Graph[N, E]{
+		nodeHookFunc: nodeHookFunc,
+		edgeHookFunc: edgeHookFunc,
+		outHookFunc:  func(e *E) *dlist.Hook[E] { return &edgeHookFunc(e).out },
+		inHookFunc:   func(e *E) *dlist.Hook[E] { return &edgeHookFunc(e).in },
+		nodes:        dlist.New(func(n *N) *dlist.Hook[N] { return &nodeHookFunc(n).node }),
+	}
+}
+
+// NodeCount returns number of nodes in graph
+func (g *Graph[N, E]) NodeCount() int {
+	return g.nodes.Len()
+}
+
+// EdgeCount returns number of edges in graph
+func (g *Graph[N, E]) EdgeCount() int {
+	return g.edges
+}
+
+// AddNode adds node without edges to graph.
+// Return false if node is already part of this or other graph
+func (g *Graph[N, E]) AddNode(node *N) bool {
+	hook := g.nodeHookFunc(node)
+	if hook.owner != nil {
+		return false
+	}
+	hook.owner = g
+	hook.out = dlist.New(g.outHookFunc)
+	hook.in = dlist.New(g.inHookFunc)
+	hook.epoch = 0
+	g.nodes.PushBack(node)
+	return true
+}
+
+// RemoveNode removes node and all its edges from graph. Return removed edges,
+// nil if node is not part of any graph
+func (g *Graph[N, E]) RemoveNode(node *N) (removed []*E) {
+	hook := g.nodeHookFunc(node)
+	if hook.owner == nil {
+		return nil
+	}
+	g.verifyIsMemberOfCurrent(node)
+	for !hook.out.Empty() {
+		e := hook.out.Front()
+		g.RemoveEdge(e)
+		removed = append(removed, e)
+	}
+	for !hook.in.Empty() {
+		e := hook.in.Front()
+		g.RemoveEdge(e)
+		removed = append(removed, e)
+	}
+	g.nodes.Erase(node)
+	*hook = NodeHook[N, E]{}
+	return
+}
+
+// AddEdge links edge from one node to another. Both nodes MUST be part of graph
+func (g *Graph[N, E]) AddEdge(edge *E, from, to *N) {
+	g.verifyNotLinked(edge)
+	g.verifyIsMemberOfCurrent(from)
+	g.verifyIsMemberOfCurrent(to)
+	hook := g.edgeHookFunc(edge)
+	hook.from, hook.to = from, to
+	g.nodeHookFunc(from).out.PushBack(edge)
+	g.nodeHookFunc(to).in.PushBack(edge)
+	g.edges++
+}
+
+// RemoveEdge unlinks edge from its nodes. Return false if edge is not linked
+func (g *Graph[N, E]) RemoveEdge(edge *E) bool {
+	hook := g.edgeHookFunc(edge)
+	if hook.from == nil {
+		return false
+	}
+	g.verifyIsMemberOfCurrent(hook.from)
+	g.nodeHookFunc(hook.from).out.Erase(edge)
+	g.nodeHookFunc(hook.to).in.Erase(edge)
+	hook.from, hook.to = nil, nil
+	g.edges--
+	return true
+}
+
+// From returns source node of edge
+func (g *Graph[N, E]) From(edge *E) *N {
+	return g.edgeHookFunc(edge).from
+}
+
+// To returns target node of edge
+func (g *Graph[N, E]) To(edge *E) *N {
+	return g.edgeHookFunc(edge).to
+}
+
+// OutDegree returns number of edges from node
+func (g *Graph[N, E]) OutDegree(node *N) int {
+	return g.nodeHookFunc(node).out.Len()
+}
+
+// InDegree returns number of edges to node
+func (g *Graph[N, E]) InDegree(node *N) int {
+	return g.nodeHookFunc(node).in.Len()
+}
+
+// Return iterator over list that obtains next element before yield is called
+func each[T any](list *dlist.DList[T]) iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for e := list.Front(); e != nil; {
+			next := list.Next(e)
+			if !yield(e) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Nodes returns iterator over nodes in order of addition. Loop body MAY remove its argument
+func (g *Graph[N, E]) Nodes() iter.Seq[*N] {
+	return each(&g.nodes)
+}
+
+// OutEdges returns iterator over edges from node in order of addition. Loop body MAY remove its argument
+func (g *Graph[N, E]) OutEdges(node *N) iter.Seq[*E] {
+	return each(&g.nodeHookFunc(node).out)
+}
+
+// InEdges returns iterator over edges to node in order of addition. Loop body MAY remove its argument
+func (g *Graph[N, E]) InEdges(node *N) iter.Seq[*E] {
+	return each(&g.nodeHookFunc(node).in)
+}
@@ -0,0 +1,54 @@
+//go:build debug
+
+package graph
+
+import (
+	"testing"
+)
+
+func TestGraphVerifyNotLinkedPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("AddEdge should panic on linked edge")
+		}
+	}()
+
+	g, nodes, edges := newTestGraph("ab", "ab")
+	g.AddEdge(&edges[0], nodes['b'], nodes['a'])
+}
+
+func TestGraphVerifyIsMemberOfCurrentPanic(t *testing.T) {
+	tests := map[string]func(g, other *Graph[testNode, testEdge], node *testNode, edge *testEdge){
+		"AddEdge-foreign": func(g, other *Graph[testNode, testEdge], node *testNode, edge *testEdge) {
+			other.AddEdge(&testEdge{}, node, node)
+		},
+		"AddEdge-not-linked": func(g, other *Graph[testNode, testEdge], node *testNode, edge *testEdge) {
+			g.AddEdge(&testEdge{}, node, &testNode{})
+		},
+		"RemoveEdge": func(g, other *Graph[testNode, testEdge], node *testNode, edge *testEdge) {
+			other.RemoveEdge(edge)
+		},
+		"RemoveNode": func(g, other *Graph[testNode, testEdge], node *testNode, edge *testEdge) {
+			other.RemoveNode(node)
+		},
+		"DFS": func(g, other *Graph[testNode, testEdge], node *testNode, edge *testEdge) {
+			other.DFS(node, func(*testNode) bool { return true })
+		},
+		"BFS": func(g, other *Graph[testNode, testEdge], node *testNode, edge *testEdge) {
+			other.BFS(node, func(*testNode) bool { return true })
+		},
+	}
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%s should panic for node of other graph", name)
+				}
+			}()
+
+			g, nodes, edges := newTestGraph("ab", "ab")
+			other := New(nodeHook, edgeHook)
+			call(g, &other, nodes['a'], &edges[0])
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+type (
+	testNode struct {
+		hook NodeHook[testNode, testEdge]
+		name string
+	}
+
+	testEdge struct {
+		hook EdgeHook[testNode, testEdge]
+		id   int
+	}
+)
+
+func nodeHook(self *testNode) *NodeHook[testNode, testEdge] {
+	return &self.hook
+}
+
+func edgeHook(self *testEdge) *EdgeHook[testNode, testEdge] {
+	return &self.hook
+}
+
+// Build graph of nodes named by single letters and edges given as pairs of letters, e.g. "ab"
+func newTestGraph(names string, edges ...string) (*Graph[testNode, testEdge], map[byte]*testNode, []testEdge) {
+	g := New(nodeHook, edgeHook)
+	nodes := make(map[byte]*testNode)
+	for i := range len(names) {
+		node := &testNode{hook: NewNodeHook[testNode, testEdge](), name: names[i : i+1]}
+		nodes[names[i]] = node
+		g.AddNode(node)
+	}
+	items := make([]testEdge, len(edges))
+	for i, e := range edges {
+		items[i] = testEdge{hook: NewEdgeHook[testNode, testEdge](), id: i}
+		g.AddEdge(&items[i], nodes[e[0]], nodes[e[1]])
+	}
+	return &g, nodes, items
+}
+
+func nodeNames(nodes []*testNode) (names string) {
+	for _, n := range nodes {
+		names += n.name
+	}
+	return
+}
+
+func edgeIds(edges func(func(*testEdge) bool)) (ids []int) {
+	for e := range edges {
+		ids = append(ids, e.id)
+	}
+	return
+}
+
+/// Graph
+
+func TestGraphEdges(t *testing.T) {
+	g, nodes, edges := newTestGraph("abc", "ab", "ac", "bc", "ca", "cc", "ab")
+	if g.NodeCount() != 3 || g.EdgeCount() != 6 {
+		t.Fatalf("graph has %d nodes and %d edges", g.NodeCount(), g.EdgeCount())
+	}
+	tests := map[string]struct {
+		node     byte
+		out, in  []int
+		outD, iD int
+	}{
+		"a": {'a', []int{0, 1, 5}, []int{3}, 3, 1},
+		"b": {'b', []int{2}, []int{0, 5}, 1, 2},
+		"c": {'c', []int{3, 4}, []int{1, 2, 4}, 2, 3},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			node := nodes[tt.node]
+			if got := edgeIds(g.OutEdges(node)); !slices.Equal(got, tt.out) || g.OutDegree(node) != tt.outD {
+				t.Errorf("out edges %v, want %v", got, tt.out)
+			}
+			if got := edgeIds(g.InEdges(node)); !slices.Equal(got, tt.in) || g.InDegree(node) != tt.iD {
+				t.Errorf("in edges %v, want %v", got, tt.in)
+			}
+		})
+	}
+	if g.From(&edges[2]) != nodes['b'] || g.To(&edges[2]) != nodes['c'] {
+		t.Errorf("edge 2 links %v to %v", g.From(&edges[2]), g.To(&edges[2]))
+	}
+}
+
+func TestGraphRemoveEdge(t *testing.T) {
+	g, nodes, edges := newTestGraph("ab", "ab", "ba", "ab", "aa")
+	for e := range g.OutEdges(nodes['a']) {
+		if e.id != 2 {
+			g.RemoveEdge(e)
+		}
+	}
+	if got := edgeIds(g.OutEdges(nodes['a'])); !slices.Equal(got, []int{2}) || g.EdgeCount() != 2 {
+		t.Errorf("out edges %v after removal, graph has %d edges", got, g.EdgeCount())
+	}
+	if got := edgeIds(g.InEdges(nodes['a'])); !slices.Equal(got, []int{1}) {
+		t.Errorf("in edges %v after removal", got)
+	}
+	if edges[0].hook != NewEdgeHook[testNode, testEdge]() || edges[3].hook != NewEdgeHook[testNode, testEdge]() {
+		t.Errorf("hook of removed edge is not reset")
+	}
+}
+
+func TestGraphRemoveNode(t *testing.T) {
+	g, nodes, _ := newTestGraph("abc", "ab", "ba", "bc", "ac", "bb")
+	removed := g.RemoveNode(nodes['b'])
+	ids := edgeIds(slices.Values(removed))
+	slices.Sort(ids)
+	if !slices.Equal(ids, []int{0, 1, 2, 4}) {
+		t.Errorf("removed edges %v", ids)
+	}
+	if g.NodeCount() != 2 || g.EdgeCount() != 1 || g.OutDegree(nodes['a']) != 1 || g.InDegree(nodes['a']) != 0 {
+		t.Errorf("graph has %d nodes and %d edges", g.NodeCount(), g.EdgeCount())
+	}
+	var names []string
+	for n := range g.Nodes() {
+		names = append(names, n.name)
+	}
+	if !slices.Equal(names, []string{"a", "c"}) {
+		t.Errorf("graph has nodes %v", names)
+	}
+	if nodes['b'].hook.out.Len() != 0 || nodes['b'].hook.in.Len() != 0 {
+		t.Errorf("removed node has edges")
+	}
+}
+
+func TestGraphAddNodeLinked(t *testing.T) {
+	g, nodes, _ := newTestGraph("ab", "ab")
+	other := New(nodeHook, edgeHook)
+	if g.AddNode(nodes['a']) || other.AddNode(nodes['a']) {
+		t.Errorf("linked node is added again")
+	}
+	if g.NodeCount() != 2 || other.NodeCount() != 0 || g.OutDegree(nodes['a']) != 1 {
+		t.Errorf("graph has %d nodes, node has %d out edges", g.NodeCount(), g.OutDegree(nodes['a']))
+	}
+	g.RemoveNode(nodes['a'])
+	if !other.AddNode(nodes['a']) || other.NodeCount() != 1 {
+		t.Errorf("removed node is not added to other graph")
+	}
+}
+
+func TestGraphRemoveNotLinked(t *testing.T) {
+	g, nodes, edges := newTestGraph("ab", "ab")
+	if !g.RemoveEdge(&edges[0]) {
+		t.Errorf("linked edge is not removed")
+	}
+	if g.RemoveEdge(&edges[0]) || g.EdgeCount() != 0 {
+		t.Errorf("edge is removed twice, graph has %d edges", g.EdgeCount())
+	}
+	node := testNode{hook: NewNodeHook[testNode, testEdge](), name: "c"}
+	if removed := g.RemoveNode(&node); removed != nil || g.NodeCount() != 2 {
+		t.Errorf("not linked node is removed, graph has %d nodes", g.NodeCount())
+	}
+	g.RemoveNode(nodes['a'])
+	if removed := g.RemoveNode(nodes['a']); removed != nil || g.NodeCount() != 1 {
+		t.Errorf("node is removed twice, graph has %d nodes", g.NodeCount())
+	}
+}
@@ -0,0 +1,19 @@
+//go:build debug
+
+package graph
+
+import (
+	"fmt"
+)
+
+func (g *Graph[N, E]) verifyNotLinked(edge *E) {
+	if g.edgeHookFunc(edge).from != nil {
+		panic(fmt.Sprintf("already linked edge detected: Graph %p edge: %p", g, edge))
+	}
+}
+
+func (g *Graph[N, E]) verifyIsMemberOfCurrent(node *N) {
+	if g.nodeHookFunc(node).owner != g {
+		panic(fmt.Sprintf("not member of detected: Graph %p node: %p", g, node))
+	}
+}
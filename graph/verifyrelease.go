@@ -0,0 +1,9 @@
+//go:build !debug
+
+package graph
+
+func (g *Graph[N, E]) verifyNotLinked(edge *E) {
+}
+
+func (g *Graph[N, E]) verifyIsMemberOfCurrent(node *N) {
+}
@@ -0,0 +1,68 @@
+package hashtable
+
+type (
+	// HashMap is intrusive hash container of elements with unique keys.
+	// Key of an element MUST NOT change while it is inside map
+	HashMap[K comparable, T any] struct {
+		Table[T]
+		keyFunc  func(*T) K
+		hashFunc func(K) uint64
+	}
+)
+
+// NewHashMap creates a new HashMap of elements identified by key returned by keyFunc
+func NewHashMap[K comparable, T any](hookFunc func(*T) *Hook[T], keyFunc func(*T) K, hashFunc func(K) uint64) *HashMap[K, T] {
+	return &HashMap[K, T]{Table: Table[T]{newTable(hookFunc)}, keyFunc: keyFunc, hashFunc: hashFunc}
+}
+
+// Insert adds element if map has no element with the same key. Return true if element was inserted
+func (m *HashMap[K, T]) Insert(element *T) bool {
+	key := m.keyFunc(element)
+	hash := m.hashFunc(key)
+	m.table.prepare(hash)
+	if m.table.find(hash, func(e *T) bool { return m.keyFunc(e) == key }) != nil {
+		return false
+	}
+	m.table.insertAfter(nil, element, hash)
+	return true
+}
+
+// Replace inserts element in place of element with the same key. Return replaced element or nil
+func (m *HashMap[K, T]) Replace(element *T) *T {
+	key := m.keyFunc(element)
+	hash := m.hashFunc(key)
+	m.table.prepare(hash)
+	old := m.table.find(hash, func(e *T) bool { return m.keyFunc(e) == key })
+	if old != nil {
+		m.table.erase(old)
+	}
+	m.table.insertAfter(nil, element, hash)
+	return old
+}
+
+// Find searches for an element with key
+func (m *HashMap[K, T]) Find(key K) *T {
+	hash := m.hashFunc(key)
+	m.table.prepare(hash)
+	return m.table.find(hash, func(e *T) bool { return m.keyFunc(e) == key })
+}
+
+// Contains checks if map has an element with key
+func (m *HashMap[K, T]) Contains(key K) bool {
+	return m.Find(key) != nil
+}
+
+// Erase removes element from map. Return false if element is not part of map
+func (m *HashMap[K, T]) Erase(element *T) bool {
+	m.table.prepare(m.table.hookFunc(element).hash)
+	return m.table.erase(element)
+}
+
+// EraseKey removes element with key from map. Return removed element or nil
+func (m *HashMap[K, T]) EraseKey(key K) *T {
+	element := m.Find(key)
+	if element != nil {
+		m.table.erase(element)
+	}
+	return element
+}
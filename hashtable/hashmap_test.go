@@ -0,0 +1,91 @@
+package hashtable
+
+import (
+	"testing"
+)
+
+/// HashMap
+
+func TestHashMapInsertReplace(t *testing.T) {
+	m := NewHashMap(testHook, testKey, testHash)
+	first := testItem{hook: NewHook[testItem](), key: 1, value: 10}
+	second := testItem{hook: NewHook[testItem](), key: 1, value: 20}
+	if !m.Insert(&first) || m.Insert(&second) {
+		t.Fatalf("insert of duplicate key succeeded")
+	}
+	if m.Replace(&second) != &first || m.Find(1) != &second || m.Len() != 1 {
+		t.Fatalf("replace did not substitute element")
+	}
+	if first.hook != NewHook[testItem]() {
+		t.Errorf("hook of replaced element is not reset")
+	}
+	third := testItem{hook: NewHook[testItem](), key: 2, value: 30}
+	if m.Replace(&third) != nil || m.Size() != 2 {
+		t.Errorf("replace of absent key returned element or map has size %d", m.Size())
+	}
+	verifyTable(t, &m.table)
+}
+
+func TestHashMapFindErase(t *testing.T) {
+	tests := map[string]struct {
+		erase   []int
+		present []int
+		absent  []int
+		size    int
+	}{
+		"nothing": {nil, []int{0, 5, 9}, []int{10, -1}, 10},
+		"some":    {[]int{0, 5}, []int{1, 9}, []int{0, 5}, 8},
+		"absent":  {[]int{100}, []int{0, 9}, []int{100}, 10},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			items := newTestItems(10)
+			m := NewHashMap(testHook, testKey, testHash)
+			for i := range items {
+				m.Insert(&items[i])
+			}
+			for _, key := range tt.erase {
+				if e := m.EraseKey(key); (e != nil) != (key >= 0 && key < len(items)) {
+					t.Errorf("EraseKey(%d) = %v", key, e)
+				}
+			}
+			for _, key := range tt.present {
+				if e := m.Find(key); e == nil || e.value != key || !m.Contains(key) {
+					t.Errorf("Find(%d) = %v", key, e)
+				}
+			}
+			for _, key := range tt.absent {
+				if m.Contains(key) {
+					t.Errorf("map contains %d", key)
+				}
+			}
+			if m.Len() != tt.size || m.Empty() {
+				t.Errorf("map has size %d", m.Len())
+			}
+			verifyTable(t, &m.table)
+		})
+	}
+}
+
+func TestHashMapErase(t *testing.T) {
+	items := newTestItems(3)
+	m := NewHashMap(testHook, testKey, testHash)
+	m.Insert(&items[0])
+	m.Insert(&items[1])
+	if !m.Erase(&items[0]) || m.Erase(&items[0]) || m.Erase(&items[2]) {
+		t.Errorf("erase returned wrong result")
+	}
+	m.SetMaxLoadFactor(2)
+	if m.MaxLoadFactor() != 2 || m.Rehashing() || m.BucketCount() != minBuckets || m.LoadFactor() != 1.0/minBuckets {
+		t.Errorf("map has load factor %v of %v in %d buckets", m.LoadFactor(), m.MaxLoadFactor(), m.BucketCount())
+	}
+	m.Reserve(64)
+	m.Traverse(func(e *testItem) {
+		if e != &items[1] {
+			t.Errorf("traverse visited %d", e.key)
+		}
+	})
+	if removed := m.Clear(); len(removed) != 1 || !m.Empty() {
+		t.Errorf("clear returned %d elements", len(removed))
+	}
+}
@@ -0,0 +1,74 @@
+package hashtable
+
+import (
+	"iter"
+)
+
+type (
+	// HashMultiMap is intrusive hash container of elements that MAY share a key.
+	// Elements with the same key are adjacent in their bucket chain.
+	// Key of an element MUST NOT change while it is inside map
+	HashMultiMap[K comparable, T any] struct {
+		Table[T]
+		keyFunc  func(*T) K
+		hashFunc func(K) uint64
+	}
+)
+
+// NewHashMultiMap creates a new HashMultiMap of elements identified by key returned by keyFunc
+func NewHashMultiMap[K comparable, T any](hookFunc func(*T) *Hook[T], keyFunc func(*T) K, hashFunc func(K) uint64) *HashMultiMap[K, T] {
+	return &HashMultiMap[K, T]{Table: Table[T]{newTable(hookFunc)}, keyFunc: keyFunc, hashFunc: hashFunc}
+}
+
+// Return predicate matching elements with key
+func (m *HashMultiMap[K, T]) keyIs(key K) func(*T) bool {
+	return func(e *T) bool { return m.keyFunc(e) == key }
+}
+
+// Insert adds element next to elements with the same key
+func (m *HashMultiMap[K, T]) Insert(element *T) {
+	key := m.keyFunc(element)
+	hash := m.hashFunc(key)
+	m.table.prepare(hash)
+	m.table.insertAfter(m.table.find(hash, m.keyIs(key)), element, hash)
+}
+
+// Find searches for the first element with key
+func (m *HashMultiMap[K, T]) Find(key K) *T {
+	hash := m.hashFunc(key)
+	m.table.prepare(hash)
+	return m.table.find(hash, m.keyIs(key))
+}
+
+// Contains checks if map has an element with key
+func (m *HashMultiMap[K, T]) Contains(key K) bool {
+	return m.Find(key) != nil
+}
+
+// Count returns number of elements with key
+func (m *HashMultiMap[K, T]) Count(key K) int {
+	count := 0
+	for range m.EqualRange(key) {
+		count++
+	}
+	return count
+}
+
+// EqualRange returns iterator over elements with key.
+// Loop body MAY erase its argument but MUST NOT insert elements into map
+func (m *HashMultiMap[K, T]) EqualRange(key K) iter.Seq[*T] {
+	return m.table.equalRange(m.hashFunc(key), m.keyIs(key))
+}
+
+// Erase removes element from map. Return false if element is not part of map
+func (m *HashMultiMap[K, T]) Erase(element *T) bool {
+	m.table.prepare(m.table.hookFunc(element).hash)
+	return m.table.erase(element)
+}
+
+// EraseAll removes all elements with key and returns them as slice
+func (m *HashMultiMap[K, T]) EraseAll(key K) []*T {
+	hash := m.hashFunc(key)
+	m.table.prepare(hash)
+	return m.table.eraseAll(hash, m.keyIs(key))
+}
@@ -0,0 +1,157 @@
+package hashtable
+
+import (
+	"slices"
+	"testing"
+)
+
+// Check that elements with the same key are adjacent in bucket chains
+func verifyGroups[K comparable, T any](t *testing.T, table *table[T], keyFunc func(*T) K) {
+	t.Helper()
+	closed := make(map[K]bool)
+	for _, buckets := range [2][]*T{table.old, table.buckets} {
+		for _, head := range buckets {
+			for e := head; e != nil; e = table.hookFunc(e).next {
+				key := keyFunc(e)
+				if closed[key] {
+					t.Fatalf("elements with key %v are not adjacent", key)
+				}
+				if next := table.hookFunc(e).next; next == nil || keyFunc(next) != key {
+					closed[key] = true
+				}
+			}
+		}
+	}
+}
+
+func newTestMultiItems(count, keys int) []testItem {
+	items := make([]testItem, count)
+	for i := range items {
+		items[i] = testItem{hook: NewHook[testItem](), key: i % keys, value: i}
+	}
+	return items
+}
+
+/// HashMultiMap
+
+func TestHashMultiMapGroupsDuringRehash(t *testing.T) {
+	const keys = 37
+	items := newTestMultiItems(2000, keys)
+	m := NewHashMultiMap(testHook, testKey, testHash)
+	for i := range items {
+		m.Insert(&items[i])
+		verifyTable(t, &m.table)
+		verifyGroups(t, &m.table, testKey)
+		if want := i/keys + 1; m.Count(items[i].key) != want {
+			t.Fatalf("insert %d: key %d has count %d, want %d", i, items[i].key, m.Count(items[i].key), want)
+		}
+	}
+	for key := 0; key < keys; key++ {
+		var values []int
+		for e := range m.EqualRange(key) {
+			if e.key != key {
+				t.Fatalf("range of key %d contains key %d", key, e.key)
+			}
+			values = append(values, e.value)
+		}
+		slices.Sort(values)
+		for i, v := range values {
+			if v != key+i*keys {
+				t.Fatalf("range of key %d has values %v", key, values)
+			}
+		}
+	}
+}
+
+func TestHashMultiMapEqualRange(t *testing.T) {
+	tests := map[string]struct {
+		key   int
+		stop  int
+		count int
+	}{
+		"absent":  {100, 0, 0},
+		"full":    {3, -1, 3},
+		"stopped": {3, 2, 2},
+		"single":  {9, -1, 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			items := newTestMultiItems(13, 4)
+			items[12].key = 9
+			m := NewHashMultiMap(testHook, testKey, testHash)
+			for i := range items {
+				m.Insert(&items[i])
+			}
+			visited := 0
+			for e := range m.EqualRange(tt.key) {
+				if e.key != tt.key {
+					t.Fatalf("range of key %d contains key %d", tt.key, e.key)
+				}
+				visited++
+				if visited == tt.stop {
+					break
+				}
+			}
+			if visited != tt.count {
+				t.Errorf("range of key %d visited %d elements, want %d", tt.key, visited, tt.count)
+			}
+		})
+	}
+}
+
+func TestHashMultiMapErase(t *testing.T) {
+	items := newTestMultiItems(40, 4)
+	m := NewHashMultiMap(testHook, testKey, testHash)
+	for i := range items {
+		m.Insert(&items[i])
+	}
+	if !m.Erase(&items[0]) || m.Erase(&items[0]) || m.Count(0) != 9 {
+		t.Fatalf("erase of single element failed, key 0 has count %d", m.Count(0))
+	}
+	for e := range m.EqualRange(1) {
+		if e.value%8 == 1 {
+			m.Erase(e)
+		}
+	}
+	if m.Count(1) != 5 {
+		t.Fatalf("erase during range left %d elements with key 1", m.Count(1))
+	}
+	removed := m.EraseAll(2)
+	if len(removed) != 10 || m.Contains(2) || m.Len() != 40-1-5-10 {
+		t.Fatalf("erase all removed %d elements, map has %d elements", len(removed), m.Len())
+	}
+	for _, e := range removed {
+		if e.key != 2 || e.hook != NewHook[testItem]() {
+			t.Errorf("erase all returned element %d with hook %v", e.key, e.hook)
+		}
+	}
+	if len(m.EraseAll(2)) != 0 || m.Find(2) != nil {
+		t.Errorf("erase all of absent key removed elements")
+	}
+	if e := m.Find(3); e == nil || e.key != 3 {
+		t.Errorf("Find(3) = %v", e)
+	}
+	verifyTable(t, &m.table)
+	verifyGroups(t, &m.table, testKey)
+}
+
+func TestHashMultiMapControls(t *testing.T) {
+	items := newTestMultiItems(100, 10)
+	m := NewHashMultiMap(testHook, testKey, testHash)
+	m.SetMaxLoadFactor(4)
+	m.Reserve(100)
+	if m.MaxLoadFactor() != 4 || m.BucketCount() != 32 || m.Rehashing() {
+		t.Fatalf("map has %d buckets for load factor %v", m.BucketCount(), m.MaxLoadFactor())
+	}
+	for i := range items {
+		m.Insert(&items[i])
+	}
+	if m.BucketCount() != 32 || m.LoadFactor() != 100.0/32 || m.Size() != 100 || m.Empty() {
+		t.Errorf("map has %d elements in %d buckets", m.Size(), m.BucketCount())
+	}
+	count := 0
+	m.Traverse(func(e *testItem) { count++ })
+	if removed := m.Clear(); count != 100 || len(removed) != 100 || !m.Empty() {
+		t.Errorf("traverse visited %d elements, clear returned %d", count, len(removed))
+	}
+}
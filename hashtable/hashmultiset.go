@@ -0,0 +1,74 @@
+package hashtable
+
+import (
+	"iter"
+)
+
+type (
+	// HashMultiSet is intrusive hash container of elements that MAY be equal.
+	// Equal elements are adjacent in their bucket chain.
+	// Hash and equality of an element MUST NOT change while it is inside set
+	HashMultiSet[T any] struct {
+		Table[T]
+		hashFunc  func(*T) uint64
+		equalFunc func(lhs, rhs *T) bool
+	}
+)
+
+// NewHashMultiSet creates a new HashMultiSet of elements compared with equalFunc.
+// Elements that are equal MUST have equal hashes
+func NewHashMultiSet[T any](hookFunc func(*T) *Hook[T], hashFunc func(*T) uint64, equalFunc func(lhs, rhs *T) bool) *HashMultiSet[T] {
+	return &HashMultiSet[T]{Table: Table[T]{newTable(hookFunc)}, hashFunc: hashFunc, equalFunc: equalFunc}
+}
+
+// Return predicate matching elements equal to key
+func (s *HashMultiSet[T]) equalTo(key *T) func(*T) bool {
+	return func(e *T) bool { return s.equalFunc(e, key) }
+}
+
+// Insert adds element next to elements equal to it
+func (s *HashMultiSet[T]) Insert(element *T) {
+	hash := s.hashFunc(element)
+	s.table.prepare(hash)
+	s.table.insertAfter(s.table.find(hash, s.equalTo(element)), element, hash)
+}
+
+// Find searches for the first element that is equal to key
+func (s *HashMultiSet[T]) Find(key *T) *T {
+	hash := s.hashFunc(key)
+	s.table.prepare(hash)
+	return s.table.find(hash, s.equalTo(key))
+}
+
+// Contains checks if set has an element equal to key
+func (s *HashMultiSet[T]) Contains(key *T) bool {
+	return s.Find(key) != nil
+}
+
+// Count returns number of elements equal to key
+func (s *HashMultiSet[T]) Count(key *T) int {
+	count := 0
+	for range s.EqualRange(key) {
+		count++
+	}
+	return count
+}
+
+// EqualRange returns iterator over elements equal to key.
+// Loop body MAY erase its argument but MUST NOT insert elements into set
+func (s *HashMultiSet[T]) EqualRange(key *T) iter.Seq[*T] {
+	return s.table.equalRange(s.hashFunc(key), s.equalTo(key))
+}
+
+// Erase removes element from set. Return false if element is not part of set
+func (s *HashMultiSet[T]) Erase(element *T) bool {
+	s.table.prepare(s.table.hookFunc(element).hash)
+	return s.table.erase(element)
+}
+
+// EraseAll removes all elements equal to key and returns them as slice
+func (s *HashMultiSet[T]) EraseAll(key *T) []*T {
+	hash := s.hashFunc(key)
+	s.table.prepare(hash)
+	return s.table.eraseAll(hash, s.equalTo(key))
+}
@@ -0,0 +1,87 @@
+package hashtable
+
+import (
+	"testing"
+)
+
+func newTestMultiSet() *HashMultiSet[testItem] {
+	return NewHashMultiSet(testHook, testItemHash, testItemEqual)
+}
+
+/// HashMultiSet
+
+func TestHashMultiSetInsertCount(t *testing.T) {
+	tests := map[string]struct {
+		keys  []int
+		key   int
+		count int
+	}{
+		"absent":   {[]int{1, 2}, 3, 0},
+		"single":   {[]int{1, 2}, 1, 1},
+		"repeated": {[]int{1, 2, 1, 3, 1}, 1, 3},
+		"empty":    {nil, 1, 0},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newTestMultiSet()
+			for _, key := range tt.keys {
+				s.Insert(&testItem{hook: NewHook[testItem](), key: key})
+			}
+			key := testItem{key: tt.key}
+			if got := s.Count(&key); got != tt.count {
+				t.Errorf("Count(%d) = %d, want %d", tt.key, got, tt.count)
+			}
+			if s.Contains(&key) != (tt.count != 0) || s.Len() != len(tt.keys) || s.Size() != len(tt.keys) || s.Empty() != (len(tt.keys) == 0) {
+				t.Errorf("set of %d elements contains %d: %v", s.Len(), tt.key, s.Contains(&key))
+			}
+			verifyGroups(t, &s.table, testKey)
+		})
+	}
+}
+
+func TestHashMultiSetGroupsDuringRehash(t *testing.T) {
+	items := newTestMultiItems(1000, 50)
+	s := newTestMultiSet()
+	for i := range items {
+		s.Insert(&items[i])
+		verifyTable(t, &s.table)
+		verifyGroups(t, &s.table, testKey)
+	}
+	for key := 0; key < 50; key++ {
+		k := testItem{key: key}
+		if s.Count(&k) != 20 || s.Find(&k) == nil || s.Find(&k).key != key {
+			t.Fatalf("key %d has count %d", key, s.Count(&k))
+		}
+	}
+}
+
+func TestHashMultiSetErase(t *testing.T) {
+	items := newTestMultiItems(30, 3)
+	s := newTestMultiSet()
+	for i := range items {
+		s.Insert(&items[i])
+	}
+	key := testItem{key: 1}
+	for e := range s.EqualRange(&key) {
+		if !s.Erase(e) {
+			t.Fatalf("element %d not erased", e.value)
+		}
+	}
+	if s.Contains(&key) || s.Erase(&items[1]) {
+		t.Fatalf("set contains erased elements")
+	}
+	key.key = 2
+	if removed := s.EraseAll(&key); len(removed) != 10 || s.Len() != 10 {
+		t.Errorf("erase all removed %d elements, set has %d", len(removed), s.Len())
+	}
+	s.SetMaxLoadFactor(0.5)
+	s.Reserve(100)
+	if s.MaxLoadFactor() != 0.5 || s.BucketCount() != 256 || !s.Rehashing() || s.LoadFactor() != 10.0/256 {
+		t.Errorf("set has %d buckets for load factor %v", s.BucketCount(), s.MaxLoadFactor())
+	}
+	count := 0
+	s.Traverse(func(e *testItem) { count++ })
+	if removed := s.Clear(); count != 10 || len(removed) != 10 || !s.Empty() {
+		t.Errorf("traverse visited %d elements, clear returned %d", count, len(removed))
+	}
+}
@@ -0,0 +1,46 @@
+package hashtable
+
+type (
+	// HashSet is intrusive hash container of unique elements.
+	// Hash and equality of an element MUST NOT change while it is inside set
+	HashSet[T any] struct {
+		Table[T]
+		hashFunc  func(*T) uint64
+		equalFunc func(lhs, rhs *T) bool
+	}
+)
+
+// NewHashSet creates a new HashSet of elements compared with equalFunc. Elements
+// that are equal MUST have equal hashes
+func NewHashSet[T any](hookFunc func(*T) *Hook[T], hashFunc func(*T) uint64, equalFunc func(lhs, rhs *T) bool) *HashSet[T] {
+	return &HashSet[T]{Table: Table[T]{newTable(hookFunc)}, hashFunc: hashFunc, equalFunc: equalFunc}
+}
+
+// Insert adds element if set has no equal element. Return true if element was inserted
+func (s *HashSet[T]) Insert(element *T) bool {
+	hash := s.hashFunc(element)
+	s.table.prepare(hash)
+	if s.table.find(hash, func(e *T) bool { return s.equalFunc(e, element) }) != nil {
+		return false
+	}
+	s.table.insertAfter(nil, element, hash)
+	return true
+}
+
+// Find searches for an element that is equal to key
+func (s *HashSet[T]) Find(key *T) *T {
+	hash := s.hashFunc(key)
+	s.table.prepare(hash)
+	return s.table.find(hash, func(e *T) bool { return s.equalFunc(e, key) })
+}
+
+// Contains checks if set has an element equal to key
+func (s *HashSet[T]) Contains(key *T) bool {
+	return s.Find(key) != nil
+}
+
+// Erase removes element from set. Return false if element is not part of set
+func (s *HashSet[T]) Erase(element *T) bool {
+	s.table.prepare(s.table.hookFunc(element).hash)
+	return s.table.erase(element)
+}
@@ -0,0 +1,139 @@
+package hashtable
+
+import (
+	"slices"
+	"testing"
+)
+
+func testItemHash(self *testItem) uint64 {
+	return testHash(self.key)
+}
+
+func testItemEqual(lhs, rhs *testItem) bool {
+	return lhs.key == rhs.key
+}
+
+func newTestSet() *HashSet[testItem] {
+	return NewHashSet(testHook, testItemHash, testItemEqual)
+}
+
+/// HashSet
+
+func TestHashSetInsert(t *testing.T) {
+	tests := map[string]struct {
+		keys []int
+		want []bool
+		size int
+	}{
+		"unique":     {[]int{1, 2, 3}, []bool{true, true, true}, 3},
+		"duplicates": {[]int{1, 1, 2, 1}, []bool{true, false, true, false}, 2},
+		"single":     {[]int{0}, []bool{true}, 1},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := newTestSet()
+			for i, key := range tt.keys {
+				item := &testItem{hook: NewHook[testItem](), key: key}
+				if got := s.Insert(item); got != tt.want[i] {
+					t.Errorf("Insert(%d) = %v, want %v", key, got, tt.want[i])
+				}
+			}
+			if s.Size() != tt.size || s.Len() != tt.size || s.Empty() != (tt.size == 0) {
+				t.Errorf("set has size %d, want %d", s.Size(), tt.size)
+			}
+			verifyTable(t, &s.table)
+		})
+	}
+}
+
+func TestHashSetFindErase(t *testing.T) {
+	items := newTestItems(100)
+	s := newTestSet()
+	for i := range items {
+		s.Insert(&items[i])
+	}
+	key := testItem{key: 42}
+	if s.Find(&key) != &items[42] || !s.Contains(&key) {
+		t.Fatalf("element 42 not found")
+	}
+	if !s.Erase(&items[42]) {
+		t.Fatalf("element 42 not erased")
+	}
+	if s.Erase(&items[42]) {
+		t.Errorf("element 42 erased twice")
+	}
+	if s.Contains(&key) || s.Len() != 99 {
+		t.Errorf("set has element 42 or size %d after erase", s.Len())
+	}
+	if items[42].hook != NewHook[testItem]() {
+		t.Errorf("hook of erased element is not reset")
+	}
+	if absent := (testItem{key: 1000}); s.Find(&absent) != nil {
+		t.Errorf("absent element found")
+	}
+	verifyTable(t, &s.table)
+}
+
+func TestHashSetClearTraverse(t *testing.T) {
+	items := newTestItems(50)
+	s := newTestSet()
+	for i := range items {
+		s.Insert(&items[i])
+	}
+	var visited []int
+	s.Traverse(func(e *testItem) {
+		visited = append(visited, e.key)
+		if e.key%2 == 0 {
+			s.Erase(e)
+		}
+	})
+	slices.Sort(visited)
+	if len(visited) != 50 || visited[0] != 0 || visited[49] != 49 {
+		t.Fatalf("traverse visited %v", visited)
+	}
+	if s.Len() != 25 {
+		t.Fatalf("set has %d elements after erasing in traverse", s.Len())
+	}
+	removed := s.Clear()
+	if len(removed) != 25 || !s.Empty() || s.BucketCount() != minBuckets {
+		t.Errorf("clear returned %d elements, set has %d elements in %d buckets", len(removed), s.Len(), s.BucketCount())
+	}
+	for _, e := range removed {
+		if e.key%2 == 0 || e.hook != NewHook[testItem]() {
+			t.Errorf("clear returned element %d with hook %v", e.key, e.hook)
+		}
+	}
+}
+
+func TestHashSetReserveLoadFactor(t *testing.T) {
+	s := newTestSet()
+	if s.MaxLoadFactor() != defaultMaxLoadFactor || s.LoadFactor() != 0 {
+		t.Fatalf("new set has load factor %v of %v", s.LoadFactor(), s.MaxLoadFactor())
+	}
+	s.Reserve(100)
+	if s.BucketCount() != 128 || s.Rehashing() {
+		t.Fatalf("reserve of empty set has %d buckets, rehashing %v", s.BucketCount(), s.Rehashing())
+	}
+	items := newTestItems(100)
+	for i := range items {
+		s.Insert(&items[i])
+	}
+	if s.BucketCount() != 128 {
+		t.Errorf("reserved set grew to %d buckets", s.BucketCount())
+	}
+	if s.LoadFactor() != 100.0/128 {
+		t.Errorf("load factor is %v", s.LoadFactor())
+	}
+	s.Reserve(10)
+	if s.BucketCount() != 128 {
+		t.Errorf("reserve shrunk set to %d buckets", s.BucketCount())
+	}
+	s.SetMaxLoadFactor(0.25)
+	if s.MaxLoadFactor() != 0.25 || s.BucketCount() != 512 || !s.Rehashing() {
+		t.Errorf("set has %d buckets for load factor %v", s.BucketCount(), s.MaxLoadFactor())
+	}
+	verifyTable(t, &s.table)
+	for _, f := range []float64{0, -1} {
+		expectPanic(t, func() { s.SetMaxLoadFactor(f) })
+	}
+}
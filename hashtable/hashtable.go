@@ -0,0 +1,358 @@
+// Package hashtable implements intrusive hash containers HashSet and HashMap with
+// unique elements, and HashMultiSet and HashMultiMap that keep equal elements
+// adjacent in a bucket chain.
+//
+// Buckets are chains of elements linked through Hook that also caches hash of
+// an element. When load factor exceeds maximum, bucket array is doubled and
+// elements are migrated incrementally: every operation moves a bounded number of
+// buckets from the old array, so no single operation pays for rehashing of all
+// elements. Operations on a key always migrate the bucket of that key first, so
+// elements with equal keys are never split between arrays. Growth requested during
+// rehashing is deferred until the old array is drained
+package hashtable
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+const (
+	// Number of buckets of empty container
+	minBuckets = 8
+	// Number of old buckets visited by every operation during rehashing
+	rehashStep = 8
+	// Maximum load factor of new container
+	defaultMaxLoadFactor = 1.0
+)
+
+type (
+	// Hook structure to insert/embed into concrete types
+	// of elements of hash containers
+	Hook[T any] struct {
+		next *T
+		hash uint64
+	}
+
+	// Bucket array with incremental rehashing shared by hash containers
+	table[T any] struct {
+		hookFunc func(*T) *Hook[T]
+		buckets  []*T
+		// Buckets being migrated or nil if rehashing is not in progress
+		old []*T
+		// Index of the first old bucket that may be not migrated
+		cursor int
+		// Bucket count requested while rehashing is in progress or zero
+		pending int
+		// Number of active traversals, migration is suspended while it is not zero
+		traversing    int
+		size          int
+		maxLoadFactor float64
+	}
+
+	// Table is embedded into hash containers and provides methods
+	// that don't depend on keys of elements
+	Table[T any] struct {
+		table table[T]
+	}
+)
+
+// Initialize hook to empty state.
+//
+// WARNING: Calling this function on linked Hook will damage container structure
+func (h *Hook[T]) Init() {
+	h.next = nil
+	h.hash = 0
+}
+
+// Create hook in empty state
+func NewHook[T any]() Hook[T] {
+	return Hook[T]{next: nil, hash: 0}
+}
+
+func newTable[T any](hookFunc func(*T) *Hook[T]) table[T] {
+	return table[T]{hookFunc: hookFunc, buckets: make([]*T, minBuckets), maxLoadFactor: defaultMaxLoadFactor}
+}
+
+// Return number of buckets required to hold count elements without exceeding load factor
+func (t *table[T]) bucketsFor(count int) int {
+	required := int(float64(count)/t.maxLoadFactor) + 1
+	if required <= minBuckets {
+		return minBuckets
+	}
+	return 1 << bits.Len(uint(required-1))
+}
+
+// Move chain of old bucket into new buckets.
+//
+// Elements are pushed to the front of new buckets one by one, so elements with equal
+// hash that are adjacent in old bucket stay adjacent, though in reverse order. Keys of
+// old bucket are never inserted into new buckets: lookups find them in old bucket first
+func (t *table[T]) migrateBucket(index int) {
+	mask := uint64(len(t.buckets) - 1)
+	for e := t.old[index]; e != nil; {
+		hook := t.hookFunc(e)
+		next := hook.next
+		bucket := &t.buckets[hook.hash&mask]
+		hook.next = *bucket
+		*bucket = e
+		e = next
+	}
+	t.old[index] = nil
+}
+
+// Migrate bucket of hash and up to rehashStep more buckets if rehashing is in progress.
+// Deferred growth starts when the old array is drained
+func (t *table[T]) prepare(hash uint64) {
+	if t.old == nil || t.traversing != 0 {
+		return
+	}
+	if index := int(hash & uint64(len(t.old)-1)); t.old[index] != nil {
+		t.migrateBucket(index)
+	}
+	for step := 0; step < rehashStep && t.cursor < len(t.old); step, t.cursor = step+1, t.cursor+1 {
+		if t.old[t.cursor] != nil {
+			t.migrateBucket(t.cursor)
+		}
+	}
+	if t.cursor == len(t.old) {
+		t.old = nil
+		t.cursor = 0
+		if pending := t.pending; pending != 0 {
+			t.pending = 0
+			t.rehash(pending)
+			t.prepare(hash)
+		}
+	}
+}
+
+// Start incremental rehashing into bucket array of count buckets.
+// Count MUST be a power of two. If rehashing is in progress, it is recorded and started
+// when the old array is drained
+func (t *table[T]) rehash(count int) {
+	if t.old != nil {
+		t.pending = max(t.pending, count)
+		return
+	}
+	if count <= len(t.buckets) {
+		return
+	}
+	if t.size != 0 {
+		t.old = t.buckets
+	}
+	t.buckets = make([]*T, count)
+}
+
+// Start rehashing if size exceeds maximum load factor
+func (t *table[T]) grow() {
+	if float64(t.size) > t.maxLoadFactor*float64(len(t.buckets)) {
+		t.rehash(t.bucketsFor(t.size))
+	}
+}
+
+// Return link to the first element with hash satisfying match or nil. Bucket of hash in
+// old array is searched too as it is not migrated while traversal is in progress
+func (t *table[T]) findLink(hash uint64, match func(*T) bool) **T {
+	for _, buckets := range [2][]*T{t.old, t.buckets} {
+		if buckets == nil {
+			continue
+		}
+		for link := &buckets[hash&uint64(len(buckets)-1)]; *link != nil; link = &t.hookFunc(*link).next {
+			if t.hookFunc(*link).hash == hash && match(*link) {
+				return link
+			}
+		}
+	}
+	return nil
+}
+
+// Return the first element with hash satisfying match or nil. Bucket MUST be prepared
+func (t *table[T]) find(hash uint64, match func(*T) bool) *T {
+	if link := t.findLink(hash, match); link != nil {
+		return *link
+	}
+	return nil
+}
+
+// Return iterator over adjacent elements with hash satisfying match starting from
+// the first one. Bucket is prepared when iteration starts, next element is obtained
+// before yield is called
+func (t *table[T]) equalRange(hash uint64, match func(*T) bool) iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		t.prepare(hash)
+		for e := t.find(hash, match); e != nil; {
+			next := t.hookFunc(e).next
+			if !yield(e) {
+				return
+			}
+			if next == nil || t.hookFunc(next).hash != hash || !match(next) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Unlink adjacent elements with hash satisfying match and return them as slice.
+// Bucket MUST be prepared
+func (t *table[T]) eraseAll(hash uint64, match func(*T) bool) []*T {
+	link := t.findLink(hash, match)
+	if link == nil {
+		return nil
+	}
+	var removed []*T
+	for e := *link; e != nil && t.hookFunc(e).hash == hash && match(e); e = *link {
+		hook := t.hookFunc(e)
+		*link = hook.next
+		hook.Init()
+		removed = append(removed, e)
+	}
+	t.size -= len(removed)
+	return removed
+}
+
+// Link element with hash after position or at the front of its bucket if position is nil.
+// Bucket MUST be prepared
+func (t *table[T]) insertAfter(position, element *T, hash uint64) {
+	hook := t.hookFunc(element)
+	hook.hash = hash
+	if position == nil {
+		bucket := &t.buckets[hash&uint64(len(t.buckets)-1)]
+		hook.next = *bucket
+		*bucket = element
+	} else {
+		hook.next = t.hookFunc(position).next
+		t.hookFunc(position).next = element
+	}
+	t.size++
+	t.grow()
+}
+
+// Unlink element from its bucket. Return false if element is not linked.
+// Bucket MUST be prepared unless migration is suspended by traversal
+func (t *table[T]) erase(element *T) bool {
+	hook := t.hookFunc(element)
+	link := t.link(t.buckets, hook.hash, element)
+	if *link == nil && t.old != nil {
+		link = t.link(t.old, hook.hash, element)
+	}
+	if *link == nil {
+		return false
+	}
+	*link = hook.next
+	hook.Init()
+	t.size--
+	return true
+}
+
+// Return pointer to link to element in bucket of hash or to the nil link at the end of bucket
+func (t *table[T]) link(buckets []*T, hash uint64, element *T) **T {
+	link := &buckets[hash&uint64(len(buckets)-1)]
+	for *link != nil && *link != element {
+		link = &t.hookFunc(*link).next
+	}
+	return link
+}
+
+// Unlink all elements and return them as slice
+func (t *table[T]) clear() []*T {
+	elements := make([]*T, 0, t.size)
+	t.traverse(func(e *T) { elements = append(elements, e) })
+	for _, e := range elements {
+		t.hookFunc(e).Init()
+	}
+	t.buckets = make([]*T, minBuckets)
+	t.old = nil
+	t.cursor = 0
+	t.pending = 0
+	t.size = 0
+	return elements
+}
+
+// Call f for every element of both arrays. Migration is suspended during traversal so
+// f may erase its argument without moving elements between arrays, lookups search
+// both arrays meanwhile. Next element is obtained before f is called
+func (t *table[T]) traverse(f func(*T)) {
+	t.traversing++
+	defer func() { t.traversing-- }()
+	for _, buckets := range [2][]*T{t.old, t.buckets} {
+		for _, head := range buckets {
+			for e := head; e != nil; {
+				next := t.hookFunc(e).next
+				f(e)
+				e = next
+			}
+		}
+	}
+}
+
+func (t *table[T]) loadFactor() float64 {
+	return float64(t.size) / float64(len(t.buckets))
+}
+
+func (t *table[T]) setMaxLoadFactor(maxLoadFactor float64) {
+	if !(maxLoadFactor > 0) {
+		panic(fmt.Sprintf("hashtable: maximum load factor must be positive: %v", maxLoadFactor))
+	}
+	t.maxLoadFactor = maxLoadFactor
+	t.grow()
+}
+
+// Empty checks if container has no elements
+func (t *Table[T]) Empty() bool {
+	return t.table.size == 0
+}
+
+// Size returns number of elements in container
+func (t *Table[T]) Size() int {
+	return t.table.size
+}
+
+// Len returns number of elements in container
+func (t *Table[T]) Len() int {
+	return t.table.size
+}
+
+// Clear removes all elements from container and returns them as slice
+func (t *Table[T]) Clear() []*T {
+	return t.table.clear()
+}
+
+// Traverse calls f for every element in unspecified order, equal elements of multi containers
+// are visited one after another. F MAY erase its argument, look up and insert elements.
+// Elements inserted by f MAY be not visited
+func (t *Table[T]) Traverse(f func(*T)) {
+	t.table.traverse(f)
+}
+
+// Reserve makes room for count elements so they can be inserted without rehashing.
+// If rehashing is in progress, growth is deferred until it completes
+func (t *Table[T]) Reserve(count int) {
+	t.table.rehash(t.table.bucketsFor(count))
+}
+
+// BucketCount returns number of buckets
+func (t *Table[T]) BucketCount() int {
+	return len(t.table.buckets)
+}
+
+// LoadFactor returns average number of elements per bucket
+func (t *Table[T]) LoadFactor() float64 {
+	return t.table.loadFactor()
+}
+
+// MaxLoadFactor returns load factor which triggers rehashing when exceeded
+func (t *Table[T]) MaxLoadFactor() float64 {
+	return t.table.maxLoadFactor
+}
+
+// SetMaxLoadFactor sets load factor which triggers rehashing when exceeded, it MUST be positive.
+// Rehashing starts immediately if current load factor is greater
+func (t *Table[T]) SetMaxLoadFactor(maxLoadFactor float64) {
+	t.table.setMaxLoadFactor(maxLoadFactor)
+}
+
+// Rehashing checks if migration of elements into new bucket array is in progress
+func (t *Table[T]) Rehashing() bool {
+	return t.table.old != nil
+}
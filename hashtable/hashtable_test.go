@@ -0,0 +1,297 @@
+package hashtable
+
+import (
+	"testing"
+)
+
+type testItem struct {
+	hook  Hook[testItem]
+	key   int
+	value int
+}
+
+func testHook(self *testItem) *Hook[testItem] {
+	return &self.hook
+}
+
+func testKey(self *testItem) int {
+	return self.key
+}
+
+// Spread keys with multiplicative hashing so neighbouring keys land in different buckets
+func testHash(key int) uint64 {
+	return uint64(key) * 0x9E3779B97F4A7C15
+}
+
+func newTestItems(count int) []testItem {
+	items := make([]testItem, count)
+	for i := range items {
+		items[i] = testItem{hook: NewHook[testItem](), key: i, value: i}
+	}
+	return items
+}
+
+func expectPanic(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	f()
+}
+
+// Check that every element is in bucket of its hash and count matches size
+func verifyTable[T any](t *testing.T, table *table[T]) {
+	t.Helper()
+	count := 0
+	for _, buckets := range [2][]*T{table.old, table.buckets} {
+		mask := uint64(len(buckets) - 1)
+		for i, head := range buckets {
+			for e := head; e != nil; e = table.hookFunc(e).next {
+				if index := int(table.hookFunc(e).hash & mask); index != i {
+					t.Fatalf("element %p with hash %x is in bucket %d instead of %d", e, table.hookFunc(e).hash, i, index)
+				}
+				count++
+			}
+		}
+	}
+	if count != table.size {
+		t.Fatalf("table has %d elements while size is %d", count, table.size)
+	}
+	if len(table.buckets)&(len(table.buckets)-1) != 0 {
+		t.Fatalf("bucket count %d is not a power of two", len(table.buckets))
+	}
+}
+
+// Return number of non-empty old buckets
+func pendingBuckets[T any](table *table[T]) int {
+	pending := 0
+	for _, head := range table.old {
+		if head != nil {
+			pending++
+		}
+	}
+	return pending
+}
+
+/// Table
+
+func TestTableBucketsFor(t *testing.T) {
+	tests := map[string]struct {
+		count         int
+		maxLoadFactor float64
+		want          int
+	}{
+		"empty":            {0, 1, minBuckets},
+		"fits minimum":     {7, 1, minBuckets},
+		"exceeds minimum":  {8, 1, 16},
+		"large":            {1000, 1, 1024},
+		"high load factor": {1000, 4, 256},
+		"low load factor":  {100, 0.5, 256},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			table := newTable(testHook)
+			table.maxLoadFactor = tt.maxLoadFactor
+			if got := table.bucketsFor(tt.count); got != tt.want {
+				t.Errorf("bucketsFor(%d) = %d, want %d", tt.count, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTableIncrementalRehash(t *testing.T) {
+	items := newTestItems(4096)
+	m := NewHashMap(testHook, testKey, testHash)
+	sawRehashing := false
+	for i := range items {
+		before := pendingBuckets(&m.table)
+		wasRehashing := m.Rehashing()
+		m.Insert(&items[i])
+		verifyTable(t, &m.table)
+		if m.Rehashing() {
+			sawRehashing = true
+		}
+		if wasRehashing && before-pendingBuckets(&m.table) > rehashStep+1 {
+			t.Fatalf("insert %d migrated %d buckets", i, before-pendingBuckets(&m.table))
+		}
+		if m.LoadFactor() > m.MaxLoadFactor() {
+			t.Fatalf("insert %d: load factor %v exceeds maximum %v", i, m.LoadFactor(), m.MaxLoadFactor())
+		}
+	}
+	if !sawRehashing {
+		t.Fatalf("map never was rehashing")
+	}
+	for i := range items {
+		if m.Find(i) != &items[i] {
+			t.Fatalf("element %d not found", i)
+		}
+	}
+	for i := 0; m.Rehashing(); i++ {
+		if i > len(items) {
+			t.Fatalf("rehashing does not complete")
+		}
+		m.Find(i)
+	}
+	verifyTable(t, &m.table)
+}
+
+func TestTableEraseDuringRehash(t *testing.T) {
+	items := newTestItems(1024)
+	m := NewHashMap(testHook, testKey, testHash)
+	for i := range items {
+		m.Insert(&items[i])
+	}
+	m.Reserve(4 * len(items))
+	if !m.Rehashing() {
+		t.Fatalf("reserve did not start rehashing")
+	}
+	for i := 0; i < len(items); i += 2 {
+		if !m.Erase(&items[i]) {
+			t.Fatalf("element %d not erased", i)
+		}
+		verifyTable(t, &m.table)
+	}
+	for i := range items {
+		if got := m.Contains(i); got != (i%2 == 1) {
+			t.Errorf("Contains(%d) = %v", i, got)
+		}
+	}
+}
+
+// Every operation during rehashing visits a bounded number of elements regardless of size,
+// growth requested while rehashing is deferred instead of finishing migration at once
+func TestTableWorkDuringRehash(t *testing.T) {
+	const maxWork = 16 * rehashStep
+	work := 0
+	countingHook := func(self *testItem) *Hook[testItem] {
+		work++
+		return &self.hook
+	}
+	items := newTestItems(1 << 15)
+	m := NewHashMap(countingHook, testKey, testHash)
+	inserted, erased := 0, 0
+	for ; inserted < len(items)/2; inserted++ {
+		m.Insert(&items[inserted])
+	}
+
+	operations := []struct {
+		name      string
+		operation func()
+	}{
+		{"insert", func() { m.Insert(&items[inserted]); inserted++ }},
+		{"find", func() { m.Find(inserted - 1) }},
+		{"erase", func() { m.Erase(&items[erased]); erased++ }},
+		{"reserve", func() { m.Reserve(4 * len(items)) }},
+		{"load factor", func() { m.SetMaxLoadFactor(0.5) }},
+	}
+	for _, o := range operations {
+		for m.Rehashing() {
+			m.Find(0)
+		}
+		m.Reserve(m.BucketCount())
+		if !m.Rehashing() {
+			t.Fatalf("%s: reserve did not start rehashing", o.name)
+		}
+		// Reserve and SetMaxLoadFactor don't migrate buckets, Find moves rehashing forward
+		for i := 0; m.Rehashing(); i++ {
+			work = 0
+			o.operation()
+			if work > maxWork {
+				t.Fatalf("%s %d visited %d elements during rehashing", o.name, i, work)
+			}
+			m.Find(0)
+		}
+		verifyTable(t, &m.table)
+	}
+	for m.Rehashing() {
+		m.Find(0)
+	}
+	if m.MaxLoadFactor() != 0.5 || m.BucketCount() < m.table.bucketsFor(4*len(items)) {
+		t.Errorf("deferred growth is lost: %d buckets for load factor %v", m.BucketCount(), m.MaxLoadFactor())
+	}
+}
+
+func TestTableTraverseDuringRehash(t *testing.T) {
+	items := newTestItems(1024)
+	m := NewHashMap(testHook, testKey, testHash)
+	for i := range items {
+		m.Insert(&items[i])
+	}
+	m.Reserve(4 * len(items))
+	pending := pendingBuckets(&m.table)
+	visited := 0
+	m.Traverse(func(e *testItem) {
+		visited++
+		if e.key%2 == 0 && !m.Erase(e) {
+			t.Fatalf("element %d not erased during traversal", e.key)
+		}
+	})
+	if visited != len(items) || m.Len() != len(items)/2 {
+		t.Fatalf("traverse visited %d elements, map has %d", visited, m.Len())
+	}
+	if !m.Rehashing() || pendingBuckets(&m.table) > pending {
+		t.Fatalf("traverse finished rehashing")
+	}
+	verifyTable(t, &m.table)
+	for i := range items {
+		if got := m.Contains(i); got != (i%2 == 1) {
+			t.Errorf("Contains(%d) = %v", i, got)
+		}
+	}
+}
+
+func TestTableLookupInTraverseDuringRehash(t *testing.T) {
+	items := newTestItems(1024)
+	s := newTestSet()
+	for i := range items {
+		s.Insert(&items[i])
+	}
+	s.Reserve(4 * len(items))
+	if !s.Rehashing() {
+		t.Fatalf("reserve did not start rehashing")
+	}
+	added := newTestItems(2 * len(items))[len(items):]
+	visited := 0
+	s.Traverse(func(e *testItem) {
+		visited++
+		if key := (testItem{key: e.key}); s.Find(&key) != e {
+			t.Fatalf("element %d not found during traversal", e.key)
+		}
+		if duplicate := (testItem{key: e.key}); s.Insert(&duplicate) {
+			t.Fatalf("duplicate of %d inserted during traversal", e.key)
+		}
+		if e.key < len(items) && !s.Insert(&added[e.key]) {
+			t.Fatalf("element %d not inserted during traversal", added[e.key].key)
+		}
+	})
+	if visited < len(items) || s.Len() != len(items)+len(added) {
+		t.Fatalf("traverse visited %d elements, set has %d", visited, s.Len())
+	}
+	verifyTable(t, &s.table)
+	for i := range 2 * len(items) {
+		if key := (testItem{key: i}); !s.Contains(&key) {
+			t.Errorf("element %d not found after traversal", i)
+		}
+	}
+
+	m := newTestMultiSet()
+	multi := newTestMultiItems(600, 50)
+	for i := range multi[:300] {
+		m.Insert(&multi[i])
+	}
+	m.Reserve(4 * len(multi))
+	inserted := 300
+	m.Traverse(func(e *testItem) {
+		if inserted < len(multi) {
+			m.Insert(&multi[inserted])
+			inserted++
+		}
+	})
+	verifyTable(t, &m.table)
+	verifyGroups(t, &m.table, testKey)
+	if key := (testItem{key: 7}); m.Count(&key) != 12 {
+		t.Errorf("key 7 has count %d after insertion during traversal", m.Count(&key))
+	}
+}
@@ -0,0 +1,63 @@
+// Package guard implements a container guarded by read-write mutex that is embedded
+// into Sync wrappers of list and tree containers
+package guard
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// Container is implemented by pointers to containers of this module
+	Container interface {
+		Len() int
+	}
+
+	// Guarded holds pointer to container and read-write mutex that guards it.
+	//
+	// Length of container is published to atomic counter after every modification,
+	// so Len and Empty don't take the lock.
+	// Guarded MUST NOT be copied after first use
+	Guarded[C Container] struct {
+		mutex     sync.RWMutex
+		container C
+		size      atomic.Int64
+	}
+)
+
+// Make g take ownership of container. MUST be called before any method of g.
+//
+// It is a function rather than a method so that it is not promoted to types that embed Guarded
+func Init[C Container](g *Guarded[C], container C) {
+	g.container = container
+	g.size.Store(int64(container.Len()))
+}
+
+// Get current length of container without locking
+func (g *Guarded[C]) Len() int {
+	return int(g.size.Load())
+}
+
+// Check if container is empty without locking
+func (g *Guarded[C]) Empty() bool {
+	return g.Len() == 0
+}
+
+// Call f with underlying container under write lock to perform several operations atomically.
+//
+// Container MUST NOT be retained by f
+func (g *Guarded[C]) Do(f func(container C)) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	defer func() { g.size.Store(int64(g.container.Len())) }()
+	f(g.container)
+}
+
+// Call f with underlying container under read lock. Several View calls MAY run concurrently.
+//
+// Container and its elements MUST NOT be modified or retained by f
+func (g *Guarded[C]) View(f func(container C)) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	f(g.container)
+}
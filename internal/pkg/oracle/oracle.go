@@ -0,0 +1,215 @@
+// Package oracle contains simple slice based reference models of containers and
+// helpers for differential fuzz tests that replay the same commands against
+// a container and its model and compare their full contents after every step
+package oracle
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// Split commands into chunks of width bytes and call step for every chunk followed by check.
+// Trailing incomplete chunk is ignored
+func Replay(commands []byte, width int, step func(command []byte), check func(step int)) {
+	for i := 0; i+width <= len(commands); i += width {
+		step(commands[i : i+width])
+		check(i / width)
+	}
+}
+
+// Collect elements of a container by following next starting from front
+func Collect[T any](front *T, next func(*T) *T) (elements []*T) {
+	elements = make([]*T, 0)
+	for e := front; e != nil; e = next(e) {
+		elements = append(elements, e)
+	}
+	return
+}
+
+// Return description of the first difference between got and want or empty string if they are equal
+func Diff[E comparable](got, want []E) string {
+	for i := 0; i < len(got) && i < len(want); i++ {
+		if got[i] != want[i] {
+			return fmt.Sprintf("element %d differs: got %v want %v", i, got[i], want[i])
+		}
+	}
+	if len(got) != len(want) {
+		return fmt.Sprintf("length differs: got %d want %d", len(got), len(want))
+	}
+	return ""
+}
+
+// Reference model of list containers
+type List[E comparable] struct {
+	elements []E
+}
+
+// Return elements of the model in order. Result MUST NOT be modified
+func (l *List[E]) Elements() []E {
+	return l.elements
+}
+
+// Return number of elements in the model
+func (l *List[E]) Len() int {
+	return len(l.elements)
+}
+
+// Return element at index or zero value if index is out of range
+func (l *List[E]) At(index int) (e E) {
+	if index >= 0 && index < len(l.elements) {
+		e = l.elements[index]
+	}
+	return
+}
+
+// Return index of element or -1 if it is not present
+func (l *List[E]) Index(e E) int {
+	return slices.Index(l.elements, e)
+}
+
+// Insert element before index. Index equal to length of the model appends element
+func (l *List[E]) Insert(index int, e E) {
+	l.elements = slices.Insert(l.elements, index, e)
+}
+
+// Insert elements before index preserving their order
+func (l *List[E]) InsertAll(index int, elements []E) {
+	l.elements = slices.Insert(l.elements, index, elements...)
+}
+
+// Remove and return elements in range [from, to)
+func (l *List[E]) Cut(from, to int) (elements []E) {
+	elements = slices.Clone(l.elements[from:to])
+	l.elements = slices.Delete(l.elements, from, to)
+	return
+}
+
+// Remove and return element at index
+func (l *List[E]) RemoveAt(index int) (e E) {
+	e = l.elements[index]
+	l.elements = slices.Delete(l.elements, index, index+1)
+	return
+}
+
+// Insert element at the front of the model
+func (l *List[E]) PushFront(e E) {
+	l.Insert(0, e)
+}
+
+// Insert element at the back of the model
+func (l *List[E]) PushBack(e E) {
+	l.elements = append(l.elements, e)
+}
+
+// Remove and return the first element or zero value if model is empty
+func (l *List[E]) PopFront() (e E) {
+	if len(l.elements) != 0 {
+		e = l.RemoveAt(0)
+	}
+	return
+}
+
+// Remove and return the last element or zero value if model is empty
+func (l *List[E]) PopBack() (e E) {
+	if len(l.elements) != 0 {
+		e = l.RemoveAt(len(l.elements) - 1)
+	}
+	return
+}
+
+// Move all elements of other before index
+func (l *List[E]) Splice(index int, other *List[E]) {
+	if l == other {
+		return
+	}
+	l.elements = slices.Insert(l.elements, index, other.elements...)
+	other.elements = nil
+}
+
+// Remove all elements and return them in order
+func (l *List[E]) Clear() (elements []E) {
+	elements, l.elements = l.elements, nil
+	return
+}
+
+// Reverse order of elements
+func (l *List[E]) Reverse() {
+	slices.Reverse(l.elements)
+}
+
+// Stable sort of elements
+func (l *List[E]) Sort(less func(lhs, rhs E) bool) {
+	slices.SortStableFunc(l.elements, func(lhs, rhs E) int {
+		if less(lhs, rhs) {
+			return -1
+		}
+		if less(rhs, lhs) {
+			return 1
+		}
+		return 0
+	})
+}
+
+// Stable merge of sorted models: equal elements of current model go before elements of other
+func (l *List[E]) Merge(other *List[E], less func(lhs, rhs E) bool) {
+	if l == other {
+		return
+	}
+	merged := make([]E, 0, len(l.elements)+len(other.elements))
+	a, b := l.elements, other.elements
+	for len(a) != 0 && len(b) != 0 {
+		if less(b[0], a[0]) {
+			merged, b = append(merged, b[0]), b[1:]
+		} else {
+			merged, a = append(merged, a[0]), a[1:]
+		}
+	}
+	l.elements = append(append(merged, a...), b...)
+	other.elements = nil
+}
+
+// Remove all but the first element of every run of consecutive equal elements
+// and return removed elements in order
+func (l *List[E]) Unique(less func(lhs, rhs E) bool) (removed []E) {
+	removed = make([]E, 0)
+	if len(l.elements) == 0 {
+		return
+	}
+	kept := l.elements[:1]
+	for _, e := range l.elements[1:] {
+		if last := kept[len(kept)-1]; less(last, e) || less(e, last) {
+			kept = append(kept, e)
+		} else {
+			removed = append(removed, e)
+		}
+	}
+	l.elements = kept
+	return
+}
+
+// Remove elements satisfying predicate and return removed elements in order
+func (l *List[E]) RemoveIf(predicate func(e E) bool) (removed []E) {
+	removed = make([]E, 0)
+	kept := l.elements[:0]
+	for _, e := range l.elements {
+		if predicate(e) {
+			removed = append(removed, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+	l.elements = kept
+	return
+}
+
+// Return count deterministic pseudo-random command sequences of up to length bytes to seed fuzz tests
+func Seeds(count, length int) [][]byte {
+	r := rand.New(rand.NewSource(int64(count*length + 1)))
+	seeds := make([][]byte, count)
+	for i := range seeds {
+		seeds[i] = make([]byte, 1+r.Intn(length))
+		r.Read(seeds[i])
+	}
+	return seeds
+}
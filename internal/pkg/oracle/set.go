@@ -0,0 +1,148 @@
+package oracle
+
+import (
+	"slices"
+)
+
+// Reference model of ordered set containers backed by sorted slice
+type Set[E comparable] struct {
+	elements []E
+	less     func(lhs, rhs E) bool
+}
+
+// Create empty model ordered by less
+func NewSet[E comparable](less func(lhs, rhs E) bool) Set[E] {
+	return Set[E]{elements: nil, less: less}
+}
+
+func (s *Set[E]) compare(lhs, rhs E) int {
+	if s.less(lhs, rhs) {
+		return -1
+	}
+	if s.less(rhs, lhs) {
+		return 1
+	}
+	return 0
+}
+
+// Return elements of the model in order. Result MUST NOT be modified
+func (s *Set[E]) Elements() []E {
+	return s.elements
+}
+
+// Return number of elements in the model
+func (s *Set[E]) Len() int {
+	return len(s.elements)
+}
+
+// Insert element if there is no equal element. Return true if element was inserted
+func (s *Set[E]) Insert(e E) bool {
+	i, found := slices.BinarySearchFunc(s.elements, e, s.compare)
+	if found {
+		return false
+	}
+	s.elements = slices.Insert(s.elements, i, e)
+	return true
+}
+
+// Remove element itself, not an equal one. Return true if element was removed
+func (s *Set[E]) Erase(e E) bool {
+	i, found := slices.BinarySearchFunc(s.elements, e, s.compare)
+	if !found || s.elements[i] != e {
+		return false
+	}
+	s.elements = slices.Delete(s.elements, i, i+1)
+	return true
+}
+
+// Return element equal to e or zero value
+func (s *Set[E]) Find(e E) (r E) {
+	if i, found := slices.BinarySearchFunc(s.elements, e, s.compare); found {
+		r = s.elements[i]
+	}
+	return
+}
+
+// Return the first element not less than e or zero value
+func (s *Set[E]) LowerBound(e E) (r E) {
+	if i, _ := slices.BinarySearchFunc(s.elements, e, s.compare); i < len(s.elements) {
+		r = s.elements[i]
+	}
+	return
+}
+
+// Return the first element greater than e or zero value
+func (s *Set[E]) UpperBound(e E) (r E) {
+	i, found := slices.BinarySearchFunc(s.elements, e, s.compare)
+	if found {
+		i++
+	}
+	if i < len(s.elements) {
+		r = s.elements[i]
+	}
+	return
+}
+
+// Remove all elements and return them in order
+func (s *Set[E]) Clear() (elements []E) {
+	elements, s.elements = s.elements, nil
+	return
+}
+
+// Move elements of other that have no equal element in current model
+func (s *Set[E]) Merge(other *Set[E]) {
+	if s == other {
+		return
+	}
+	kept := make([]E, 0)
+	for _, e := range other.elements {
+		if !s.Insert(e) {
+			kept = append(kept, e)
+		}
+	}
+	other.elements = kept
+}
+
+// Check if every element of other has equal element in current model
+func (s *Set[E]) Includes(other *Set[E]) bool {
+	for _, e := range other.elements {
+		if _, found := slices.BinarySearchFunc(s.elements, e, s.compare); !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Return elements of current model that have no equal element in other
+func (s *Set[E]) Difference(other *Set[E]) (result []E) {
+	for _, e := range s.elements {
+		if _, found := slices.BinarySearchFunc(other.elements, e, s.compare); !found {
+			result = append(result, e)
+		}
+	}
+	return
+}
+
+// Return elements of current model that have equal element in other
+func (s *Set[E]) Intersection(other *Set[E]) (result []E) {
+	for _, e := range s.elements {
+		if _, found := slices.BinarySearchFunc(other.elements, e, s.compare); found {
+			result = append(result, e)
+		}
+	}
+	return
+}
+
+// Return elements of both models that have no equal element in the other one, in order
+func (s *Set[E]) SymDifference(other *Set[E]) []E {
+	result := append(s.Difference(other), other.Difference(s)...)
+	slices.SortStableFunc(result, s.compare)
+	return result
+}
+
+// Return elements of current model and elements of other that have no equal element in current model, in order
+func (s *Set[E]) Union(other *Set[E]) []E {
+	result := append(slices.Clone(s.elements), other.Difference(s)...)
+	slices.SortStableFunc(result, s.compare)
+	return result
+}
@@ -0,0 +1,59 @@
+// Package intrusive defines interfaces shared by intrusive containers of this module
+// and generic algorithms implemented over them.
+//
+// Containers themselves are located in subpackages: slist, dlist and rbtree
+package intrusive
+
+type (
+	// Sized is implemented by every container of this module
+	Sized interface {
+		Empty() bool
+		Size() int
+		Len() int
+	}
+
+	// Iterable is implemented by containers which elements can be visited
+	// in order starting from Front. Next returns nil after the last element
+	Iterable[T any] interface {
+		Front() *T
+		Next(element *T) *T
+	}
+
+	// Sequence is implemented by list containers with insertion at both ends
+	Sequence[T any] interface {
+		Sized
+		Iterable[T]
+		Init()
+		Back() *T
+		PushFront(element *T)
+		PopFront() *T
+		PushBack(element *T)
+		Clear() []*T
+	}
+
+	// Deque is a Sequence with removal at both ends, removal of arbitrary elements
+	// and iteration in reverse order. Prev returns nil before the first element
+	Deque[T any] interface {
+		Sequence[T]
+		Prev(element *T) *T
+		PopBack() *T
+		Erase(element *T)
+	}
+
+	// OrderedSet is implemented by containers of unique elements ordered by comparison function.
+	// Insert returns false if element that compares equal is already present
+	OrderedSet[T any] interface {
+		Sized
+		Iterable[T]
+		Init()
+		Back() *T
+		Prev(element *T) *T
+		Insert(element *T) bool
+		Erase(element *T) bool
+		Find(element *T) *T
+		Contains(element *T) bool
+		LowerBound(element *T) *T
+		UpperBound(element *T) *T
+		Clear() []*T
+	}
+)
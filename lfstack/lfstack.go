@@ -0,0 +1,106 @@
+// Package lfstack implements intrusive Treiber stack suitable for free-lists of object pools.
+//
+// Push is lock-free and MAY be called from any goroutine. Pop and PopAll are
+// serialized by a mutex which protects the stack from the ABA problem: a popper
+// reads the top element and its next pointer and then replaces top with next by
+// compare-and-swap. If between these steps the top element is popped by another
+// goroutine and pushed back, compare-and-swap succeeds with stale next pointer
+// and the stack is corrupted. Garbage collector does not help here because
+// elements are reused intentionally while they are still reachable. With a single
+// active popper an element can leave the stack only through that popper, so
+// top element can't be popped and pushed back between the read and the swap
+package lfstack
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+type (
+	// Hook structure to insert/embed into concrete types of elements of Stack.
+	//
+	// Embedded slist.Hook links elements of SList returned by PopAll
+	Hook[T any] struct {
+		slist.Hook[T]
+		next atomic.Pointer[T]
+	}
+
+	// Head structure of lock-free stack. Stack MUST NOT be copied after creation
+	Stack[T any] struct {
+		top      atomic.Pointer[T]
+		popMutex sync.Mutex
+		hookFunc func(*T) *Hook[T]
+	}
+)
+
+// Initialize hook to empty state.
+//
+// WARNING: Calling this function on linked Hook will damage Stack or SList structure
+func (h *Hook[T]) Init() {
+	h.Hook.Init()
+	h.next.Store(nil)
+}
+
+// Create new Stack container
+func New[T any](hookFunc func(*T) *Hook[T]) *Stack[T] {
+	return &Stack[T]{hookFunc: hookFunc}
+}
+
+// Insert element at the top of Stack. Safe to call from multiple goroutines
+func (s *Stack[T]) Push(element *T) {
+	hook := s.hookFunc(element)
+	for {
+		top := s.top.Load()
+		hook.next.Store(top)
+		if s.top.CompareAndSwap(top, element) {
+			return
+		}
+	}
+}
+
+// Unlink and return element at the top of Stack or nil if Stack is empty.
+// Safe to call from multiple goroutines
+func (s *Stack[T]) Pop() *T {
+	s.popMutex.Lock()
+	defer s.popMutex.Unlock()
+
+	for {
+		top := s.top.Load()
+		if top == nil {
+			return nil
+		}
+		hook := s.hookFunc(top)
+		if s.top.CompareAndSwap(top, hook.next.Load()) {
+			hook.next.Store(nil)
+			return top
+		}
+	}
+}
+
+// Unlink all elements of Stack and return them as SList linked by embedded slist.Hook,
+// the top element of Stack is the front of SList. Safe to call from multiple goroutines.
+//
+// Complexity is linear in number of elements
+func (s *Stack[T]) PopAll() slist.SList[T] {
+	list := slist.New(func(e *T) *slist.Hook[T] { return &s.hookFunc(e).Hook })
+
+	s.popMutex.Lock()
+	top := s.top.Swap(nil)
+	s.popMutex.Unlock()
+
+	for e := top; e != nil; {
+		hook := s.hookFunc(e)
+		next := hook.next.Load()
+		hook.next.Store(nil)
+		list.PushBack(e)
+		e = next
+	}
+	return list
+}
+
+// Check if Stack is empty. Result MAY be outdated by the time it is returned
+func (s *Stack[T]) Empty() bool {
+	return s.top.Load() == nil
+}
@@ -0,0 +1,176 @@
+package lfstack
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type testItem struct {
+	Hook[testItem]
+	value int
+	inUse atomic.Bool
+}
+
+func itemHook(self *testItem) *Hook[testItem] {
+	return &self.Hook
+}
+
+func newItems(count int) []testItem {
+	items := make([]testItem, count)
+	for i := range items {
+		items[i].value = i
+	}
+	return items
+}
+
+func TestStackLIFO(t *testing.T) {
+	s := New(itemHook)
+	if !s.Empty() || s.Pop() != nil {
+		t.Fatalf("new stack is not empty")
+	}
+	items := newItems(5)
+	for i := range items {
+		s.Push(&items[i])
+	}
+	for i := len(items) - 1; i >= 0; i-- {
+		e := s.Pop()
+		if e != &items[i] {
+			t.Fatalf("pop returned %v instead of %d", e, i)
+		}
+		if e.next.Load() != nil {
+			t.Errorf("popped element %d is still linked", i)
+		}
+	}
+	if !s.Empty() || s.Pop() != nil {
+		t.Errorf("stack is not empty after all elements are popped")
+	}
+}
+
+func TestStackPopAll(t *testing.T) {
+	s := New(itemHook)
+	if l := s.PopAll(); !l.Empty() {
+		t.Fatalf("PopAll of empty stack returned %d elements", l.Len())
+	}
+	items := newItems(4)
+	for i := range items {
+		s.Push(&items[i])
+	}
+	l := s.PopAll()
+	if !s.Empty() {
+		t.Errorf("stack is not empty after PopAll")
+	}
+	if err := l.Validate(); err != nil || l.Len() != len(items) {
+		t.Fatalf("PopAll returned invalid list of size %d: %v", l.Len(), err)
+	}
+	want := len(items) - 1
+	for e := l.Front(); e != nil; e = l.Next(e) {
+		if e.value != want || e.next.Load() != nil {
+			t.Errorf("PopAll list has element %d instead of %d", e.value, want)
+		}
+		want--
+	}
+
+	// Elements are reused after they are removed from SList
+	for e := l.PopFront(); e != nil; e = l.PopFront() {
+		s.Push(e)
+	}
+	if e := s.Pop(); e != &items[0] {
+		t.Errorf("reused elements are popped in wrong order")
+	}
+}
+
+func TestStackNoAllocations(t *testing.T) {
+	s := New(itemHook)
+	item := &testItem{}
+	allocs := testing.AllocsPerRun(1000, func() {
+		s.Push(item)
+		s.Pop()
+	})
+	if allocs != 0 {
+		t.Errorf("push-pop allocates %v times", allocs)
+	}
+}
+
+// Simulate free-list of object pool: every worker takes element, marks it as used,
+// releases and returns it back. ABA would hand out the same element twice
+func TestStackConcurrentReuse(t *testing.T) {
+	const workers = 8
+	const iterations = 5000
+	items := newItems(16)
+	s := New(itemHook)
+	for i := range items {
+		s.Push(&items[i])
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				if w == 0 && i%100 == 0 {
+					l := s.PopAll()
+					for e := l.PopFront(); e != nil; e = l.PopFront() {
+						s.Push(e)
+					}
+					continue
+				}
+				e := s.Pop()
+				if e == nil {
+					continue
+				}
+				if !e.inUse.CompareAndSwap(false, true) {
+					t.Errorf("element %d is handed out twice", e.value)
+					return
+				}
+				e.inUse.Store(false)
+				s.Push(e)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	l := s.PopAll()
+	if err := l.Validate(); err != nil || l.Len() != len(items) {
+		t.Errorf("stack has %d elements instead of %d after concurrent reuse: %v", l.Len(), len(items), err)
+	}
+}
+
+// Every worker holds at most one element, so Pop never finds the stack empty
+func TestStackConcurrentPopNeverEmpty(t *testing.T) {
+	const workers = 8
+	const iterations = 5000
+	items := newItems(2 * workers)
+	s := New(itemHook)
+	for i := range items {
+		s.Push(&items[i])
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				e := s.Pop()
+				if e == nil || s.Empty() {
+					t.Errorf("stack with %d elements is reported empty", len(items)-workers)
+					return
+				}
+				s.Push(e)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkStackPushPop(b *testing.B) {
+	s := New(itemHook)
+	item := &testItem{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Push(item)
+		s.Pop()
+	}
+}
@@ -0,0 +1,93 @@
+// Package mpsc implements intrusive lock-free multi-producer single-consumer queue
+// described by Dmitry Vyukov.
+//
+// Push MAY be called from any goroutine, Pop and Empty MUST be called from one
+// consumer goroutine at a time. Neither operation allocates memory
+package mpsc
+
+import (
+	"sync/atomic"
+)
+
+type (
+	// Hook structure to insert/embed into concrete types
+	// of elements of multi-producer single-consumer queue
+	Hook[T any] struct {
+		next atomic.Pointer[T]
+	}
+
+	// Head structure of multi-producer single-consumer queue.
+	// Queue MUST NOT be copied after creation
+	Queue[T any] struct {
+		// Last pushed element, modified by producers
+		head atomic.Pointer[T]
+		// Padding to keep producers and consumer on different cache lines
+		_ [56]byte
+		// Element to be popped next, owned by consumer
+		tail     *T
+		stub     *T
+		hookFunc func(*T) *Hook[T]
+	}
+)
+
+// Initialize hook to empty state.
+//
+// WARNING: Calling this function on linked Hook will damage Queue structure
+func (h *Hook[T]) Init() {
+	h.next.Store(nil)
+}
+
+// Create new Queue container. Allocates one element used as stub node
+func New[T any](hookFunc func(*T) *Hook[T]) *Queue[T] {
+	q := &Queue[T]{stub: new(T), hookFunc: hookFunc}
+	q.head.Store(q.stub)
+	q.tail = q.stub
+	return q
+}
+
+// Insert element at the back of Queue. Safe to call from multiple goroutines
+func (q *Queue[T]) Push(element *T) {
+	q.hookFunc(element).next.Store(nil)
+	prev := q.head.Swap(element)
+	// Queue is disconnected between Swap and Store: Pop returns nil until element is linked
+	q.hookFunc(prev).next.Store(element)
+}
+
+// Unlink and return element at the front of Queue or nil if Queue is empty.
+// MUST be called only from one consumer goroutine at a time.
+//
+// Pop MAY return nil while concurrent Push is in progress even if elements pushed
+// before it are not yet popped: they become available once that Push returns
+func (q *Queue[T]) Pop() *T {
+	tail := q.tail
+	next := q.hookFunc(tail).next.Load()
+	if tail == q.stub {
+		if next == nil {
+			return nil
+		}
+		q.tail = next
+		tail = next
+		next = q.hookFunc(next).next.Load()
+	}
+	if next != nil {
+		q.tail = next
+		q.hookFunc(tail).next.Store(nil)
+		return tail
+	}
+	if tail != q.head.Load() {
+		return nil
+	}
+	q.Push(q.stub)
+	if next = q.hookFunc(tail).next.Load(); next != nil {
+		q.tail = next
+		q.hookFunc(tail).next.Store(nil)
+		return tail
+	}
+	return nil
+}
+
+// Check if Queue has no elements available to Pop.
+// MUST be called only from consumer goroutine
+func (q *Queue[T]) Empty() bool {
+	return q.tail == q.stub && q.hookFunc(q.stub).next.Load() == nil
+}
@@ -0,0 +1,126 @@
+package mpsc
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+)
+
+type testItem struct {
+	Hook[testItem]
+	producer, seq int
+}
+
+func itemHook(self *testItem) *Hook[testItem] {
+	return &self.Hook
+}
+
+func TestQueueFIFO(t *testing.T) {
+	q := New(itemHook)
+	if !q.Empty() || q.Pop() != nil {
+		t.Fatalf("new queue is not empty")
+	}
+	items := make([]testItem, 5)
+	for i := range items {
+		items[i].seq = i
+		q.Push(&items[i])
+	}
+	if q.Empty() {
+		t.Errorf("queue is empty after push")
+	}
+	for i := range items {
+		e := q.Pop()
+		if e != &items[i] {
+			t.Fatalf("pop %d returned %v", i, e)
+		}
+		if e.next.Load() != nil {
+			t.Errorf("popped element %d is still linked", i)
+		}
+	}
+	if !q.Empty() || q.Pop() != nil {
+		t.Errorf("queue is not empty after all elements are popped")
+	}
+}
+
+func TestQueueInterleaved(t *testing.T) {
+	q := New(itemHook)
+	items := make([]testItem, 3)
+	q.Push(&items[0])
+	if q.Pop() != &items[0] || q.Pop() != nil {
+		t.Fatalf("single element push-pop failed")
+	}
+	q.Push(&items[1])
+	q.Push(&items[0])
+	if q.Pop() != &items[1] {
+		t.Fatalf("reused queue returned wrong element")
+	}
+	q.Push(&items[2])
+	if q.Pop() != &items[0] || q.Pop() != &items[2] || q.Pop() != nil {
+		t.Errorf("interleaved push-pop returned wrong order")
+	}
+}
+
+func TestQueueNoAllocations(t *testing.T) {
+	q := New(itemHook)
+	item := &testItem{}
+	allocs := testing.AllocsPerRun(1000, func() {
+		q.Push(item)
+		q.Pop()
+	})
+	if allocs != 0 {
+		t.Errorf("push-pop allocates %v times", allocs)
+	}
+}
+
+func TestQueueConcurrentProducers(t *testing.T) {
+	const producers = 8
+	const perProducer = 5000
+	q := New(itemHook)
+	items := make([][]testItem, producers)
+	for p := range items {
+		items[p] = make([]testItem, perProducer)
+		for i := range items[p] {
+			items[p][i] = testItem{producer: p, seq: i}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := range items[p] {
+				q.Push(&items[p][i])
+			}
+		}(p)
+	}
+
+	// Elements of each producer must be popped in order of their push
+	expected := make([]int, producers)
+	for popped := 0; popped < producers*perProducer; {
+		e := q.Pop()
+		if e == nil {
+			runtime.Gosched()
+			continue
+		}
+		if e.seq != expected[e.producer] {
+			t.Fatalf("producer %d element %d popped instead of %d", e.producer, e.seq, expected[e.producer])
+		}
+		expected[e.producer]++
+		popped++
+	}
+	wg.Wait()
+	if !q.Empty() || q.Pop() != nil {
+		t.Errorf("queue is not empty after all elements are popped")
+	}
+}
+
+func BenchmarkQueuePushPop(b *testing.B) {
+	q := New(itemHook)
+	item := &testItem{}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		q.Push(item)
+		q.Pop()
+	}
+}
@@ -0,0 +1,338 @@
+package intrusive
+
+import (
+	"testing"
+
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/internal/pkg/oracle"
+	"github.com/echo-Mike/intrusive/rbtree"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+// Item that is linked into SList, DList and RbTree at the same time
+type fuzzMultiItem struct {
+	sHook slist.Hook[fuzzMultiItem]
+	dHook dlist.Hook[fuzzMultiItem]
+	tHook rbtree.Hook[fuzzMultiItem]
+	value int
+	id    int
+}
+
+func fuzzSHook(self *fuzzMultiItem) *slist.Hook[fuzzMultiItem] {
+	return &self.sHook
+}
+
+func fuzzDHook(self *fuzzMultiItem) *dlist.Hook[fuzzMultiItem] {
+	return &self.dHook
+}
+
+func fuzzTHook(self *fuzzMultiItem) *rbtree.Hook[fuzzMultiItem] {
+	return &self.tHook
+}
+
+func lessFuzz(lhs, rhs *fuzzMultiItem) bool {
+	return lhs.value < rhs.value
+}
+
+const (
+	opSListPushFront byte = iota
+	opSListPushBack
+	opSListPopFront
+	opSListRemove
+	opSListReverse
+	opSListSort
+	opSListSplice
+	opDListPushFront
+	opDListPushBack
+	opDListPopBack
+	opDListErase
+	opDListMoveToFront
+	opDListSort
+	opDListSplice
+	opTreeInsert
+	opTreeErase
+	opTreeMerge
+	opTransfer
+	opClear
+	opCOUNT
+)
+
+// State of all containers and their reference models
+type multiState struct {
+	items   []fuzzMultiItem
+	slists  []slist.SList[fuzzMultiItem]
+	dlists  []dlist.DList[fuzzMultiItem]
+	trees   []rbtree.RbTree[fuzzMultiItem]
+	sModels []oracle.List[*fuzzMultiItem]
+	dModels []oracle.List[*fuzzMultiItem]
+	tModels []oracle.Set[*fuzzMultiItem]
+}
+
+func newMultiState(numItems, numContainers int) *multiState {
+	s := &multiState{
+		items:   make([]fuzzMultiItem, numItems),
+		slists:  make([]slist.SList[fuzzMultiItem], numContainers),
+		dlists:  make([]dlist.DList[fuzzMultiItem], numContainers),
+		trees:   make([]rbtree.RbTree[fuzzMultiItem], numContainers),
+		sModels: make([]oracle.List[*fuzzMultiItem], numContainers),
+		dModels: make([]oracle.List[*fuzzMultiItem], numContainers),
+		tModels: make([]oracle.Set[*fuzzMultiItem], numContainers),
+	}
+	for i := range s.items {
+		s.items[i] = fuzzMultiItem{
+			sHook: slist.NewHook[fuzzMultiItem](),
+			dHook: dlist.NewHook[fuzzMultiItem](),
+			tHook: rbtree.NewHook[fuzzMultiItem](),
+			value: i % 32,
+			id:    i,
+		}
+	}
+	for i := 0; i < numContainers; i++ {
+		s.slists[i] = slist.New(fuzzSHook)
+		s.dlists[i] = dlist.New(fuzzDHook)
+		s.trees[i] = rbtree.New(fuzzTHook, lessFuzz)
+		s.tModels[i] = oracle.NewSet(lessFuzz)
+	}
+	return s
+}
+
+// Return index of SList element is linked into or -1
+func (s *multiState) sOwner(e *fuzzMultiItem) int {
+	for i := range s.sModels {
+		if s.sModels[i].Index(e) >= 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Return index of DList element is linked into or -1
+func (s *multiState) dOwner(e *fuzzMultiItem) int {
+	for i := range s.dModels {
+		if s.dModels[i].Index(e) >= 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Return index of RbTree element is linked into or -1
+func (s *multiState) tOwner(e *fuzzMultiItem) int {
+	for i := range s.tModels {
+		for _, m := range s.tModels[i].Elements() {
+			if m == e {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// Unlink element from SList it is linked into if any
+func (s *multiState) sRemove(e *fuzzMultiItem) {
+	if i := s.sOwner(e); i >= 0 {
+		s.slists[i].RemoveIf(func(v *fuzzMultiItem) bool { return v == e })
+		s.sModels[i].RemoveAt(s.sModels[i].Index(e))
+	}
+}
+
+// Unlink element from DList it is linked into if any
+func (s *multiState) dRemove(e *fuzzMultiItem) {
+	if i := s.dOwner(e); i >= 0 {
+		s.dlists[i].Erase(e)
+		s.dModels[i].RemoveAt(s.dModels[i].Index(e))
+	}
+}
+
+// Unlink element from RbTree it is linked into if any
+func (s *multiState) tRemove(e *fuzzMultiItem) {
+	if i := s.tOwner(e); i >= 0 {
+		s.trees[i].Erase(e)
+		s.tModels[i].Erase(e)
+	}
+}
+
+// Apply command to containers and their reference models
+func (s *multiState) step(command []byte) {
+	op, arg1, arg2, arg3 := command[0], int(command[1]), int(command[2]), int(command[3])
+	idx, otherIdx := arg1%len(s.slists), arg3%len(s.slists)
+	item := &s.items[arg2%len(s.items)]
+	sl, sm := &s.slists[idx], &s.sModels[idx]
+	dl, dm := &s.dlists[idx], &s.dModels[idx]
+	tree, tm := &s.trees[idx], &s.tModels[idx]
+
+	switch op % opCOUNT {
+	case opSListPushFront:
+		if s.sOwner(item) < 0 {
+			sl.PushFront(item)
+			sm.PushFront(item)
+		}
+
+	case opSListPushBack:
+		if s.sOwner(item) < 0 {
+			sl.PushBack(item)
+			sm.PushBack(item)
+		}
+
+	case opSListPopFront:
+		sl.PopFront()
+		sm.PopFront()
+
+	case opSListRemove:
+		s.sRemove(item)
+
+	case opSListReverse:
+		sl.Reverse()
+		sm.Reverse()
+
+	case opSListSort:
+		sl.Sort(lessFuzz)
+		sm.Sort(lessFuzz)
+
+	case opSListSplice:
+		if idx != otherIdx {
+			sl.SpliceBack(&s.slists[otherIdx])
+			sm.Splice(sm.Len(), &s.sModels[otherIdx])
+		}
+
+	case opDListPushFront:
+		if s.dOwner(item) < 0 {
+			dl.PushFront(item)
+			dm.PushFront(item)
+		}
+
+	case opDListPushBack:
+		if s.dOwner(item) < 0 {
+			dl.PushBack(item)
+			dm.PushBack(item)
+		}
+
+	case opDListPopBack:
+		dl.PopBack()
+		dm.PopBack()
+
+	case opDListErase:
+		s.dRemove(item)
+
+	case opDListMoveToFront:
+		if i := s.dOwner(item); i >= 0 {
+			s.dlists[i].MoveToFront(item)
+			s.dModels[i].PushFront(s.dModels[i].RemoveAt(s.dModels[i].Index(item)))
+		}
+
+	case opDListSort:
+		dl.Sort(lessFuzz)
+		dm.Sort(lessFuzz)
+
+	case opDListSplice:
+		if idx != otherIdx {
+			dl.Splice(nil, &s.dlists[otherIdx])
+			dm.Splice(dm.Len(), &s.dModels[otherIdx])
+		}
+
+	case opTreeInsert:
+		if s.tOwner(item) < 0 {
+			tree.Insert(item)
+			tm.Insert(item)
+		}
+
+	case opTreeErase:
+		s.tRemove(item)
+
+	case opTreeMerge:
+		tree.Merge(&s.trees[otherIdx])
+		tm.Merge(&s.tModels[otherIdx])
+
+	case opTransfer:
+		// Move element to containers selected by bits of arg3
+		s.sRemove(item)
+		s.dRemove(item)
+		s.tRemove(item)
+		if arg3&1 != 0 {
+			sl.PushBack(item)
+			sm.PushBack(item)
+		}
+		if arg3&2 != 0 {
+			dl.PushFront(item)
+			dm.PushFront(item)
+		}
+		if arg3&4 != 0 {
+			tree.Insert(item)
+			tm.Insert(item)
+		}
+
+	case opClear:
+		// Clear containers selected by bits of arg3
+		if arg3&1 != 0 {
+			sl.Clear()
+			sm.Clear()
+		}
+		if arg3&2 != 0 {
+			dl.Clear()
+			dm.Clear()
+		}
+		if arg3&4 != 0 {
+			tree.Clear()
+			tm.Clear()
+		}
+	}
+}
+
+// Validate every container and compare its contents with the reference model
+func (s *multiState) check(t *testing.T) func(step int) {
+	return func(step int) {
+		for i := range s.slists {
+			if err := s.slists[i].Validate(); err != nil {
+				t.Fatalf("step %d: SList %d is corrupted: %v", step, i, err)
+			}
+			got := oracle.Collect(s.slists[i].Front(), func(e *fuzzMultiItem) *fuzzMultiItem { return e.sHook.Next() })
+			if diff := oracle.Diff(got, s.sModels[i].Elements()); diff != "" {
+				t.Fatalf("step %d: SList %d differs from model: %s", step, i, diff)
+			}
+
+			if err := s.dlists[i].Validate(); err != nil {
+				t.Fatalf("step %d: DList %d is corrupted: %v", step, i, err)
+			}
+			got = oracle.Collect(s.dlists[i].Front(), func(e *fuzzMultiItem) *fuzzMultiItem { return e.dHook.Next() })
+			if diff := oracle.Diff(got, s.dModels[i].Elements()); diff != "" {
+				t.Fatalf("step %d: DList %d differs from model: %s", step, i, diff)
+			}
+
+			if err := s.trees[i].Validate(); err != nil {
+				t.Fatalf("step %d: RbTree %d is corrupted: %v", step, i, err)
+			}
+			got = oracle.Collect(s.trees[i].Front(), s.trees[i].Next)
+			if diff := oracle.Diff(got, s.tModels[i].Elements()); diff != "" {
+				t.Fatalf("step %d: RbTree %d differs from model: %s", step, i, diff)
+			}
+		}
+
+		// Hooks of unlinked elements must stay in empty state
+		for i := range s.items {
+			e := &s.items[i]
+			if s.sOwner(e) < 0 && e.sHook.Next() != nil {
+				t.Fatalf("step %d: element %d is not in SList but its hook is linked", step, e.id)
+			}
+			if s.dOwner(e) < 0 && (e.dHook.Next() != nil || e.dHook.Prev() != nil) {
+				t.Fatalf("step %d: element %d is not in DList but its hook is linked", step, e.id)
+			}
+			if s.tOwner(e) < 0 && e.tHook != rbtree.NewHook[fuzzMultiItem]() {
+				t.Fatalf("step %d: element %d is not in RbTree but its hook is linked", step, e.id)
+			}
+		}
+	}
+}
+
+func FuzzMultiContainer(f *testing.F) {
+	const numItems = 64
+	const numContainers = 2
+
+	for _, seed := range oracle.Seeds(32, 512) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, commands []byte) {
+		s := newMultiState(numItems, numContainers)
+		oracle.Replay(commands, 4, s.step, s.check(t))
+	})
+}
@@ -0,0 +1,10 @@
+package pool
+
+import (
+	"errors"
+)
+
+var (
+	// Error that Pool tracking generations panics with when free element is put again
+	ErrDoublePut = errors.New("pool: element is already free")
+)
@@ -0,0 +1,128 @@
+// Package pool implements intrusive object pool that allocates elements in slabs
+// and keeps unused elements in SList linked through their embedded slist.Hook.
+//
+// Unlike sync.Pool elements are never released to garbage collector while Pool is
+// reachable, so elements MAY carry hooks of other containers and stay linked there
+// while they are in use. Get and Put do not allocate memory except when a new slab
+// is needed. Pool is not safe for concurrent use
+package pool
+
+import (
+	"fmt"
+
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+type (
+	// Counter of element reuses. Odd values mark elements in use and even values mark free elements
+	Generation uint64
+
+	// Reference to element of Pool that detects use of element after it is put back
+	Ref[T any] struct {
+		element    *T
+		generation Generation
+	}
+
+	// Head structure of object pool
+	Pool[T any] struct {
+		free           slist.SList[T]
+		slabs          [][]T
+		slabSize       int
+		generationFunc func(*T) *Generation
+	}
+)
+
+// Create new Pool with slabs of slabSize elements. Elements of unused slabs are linked
+// through slist hook returned by hookFunc. The first slab is allocated immediately
+func New[T any](slabSize int, hookFunc func(*T) *slist.Hook[T]) *Pool[T] {
+	return NewWithGeneration(slabSize, hookFunc, nil)
+}
+
+// Create new Pool that tracks generation of every element in field returned by generationFunc.
+//
+// Such Pool panics with ErrDoublePut when free element is put and invalidates
+// references created by Ref when element is put. Nil generationFunc disables tracking
+func NewWithGeneration[T any](slabSize int, hookFunc func(*T) *slist.Hook[T], generationFunc func(*T) *Generation) *Pool[T] {
+	if slabSize <= 0 {
+		panic(fmt.Sprintf("pool: slab size must be positive: %d", slabSize))
+	}
+	p := &Pool[T]{free: slist.New(hookFunc), slabSize: slabSize, generationFunc: generationFunc}
+	p.grow()
+	return p
+}
+
+// Allocate new slab and link all its elements into free list
+func (p *Pool[T]) grow() {
+	slab := make([]T, p.slabSize)
+	p.slabs = append(p.slabs, slab)
+	for i := len(slab) - 1; i >= 0; i-- {
+		p.free.PushFront(&slab[i])
+	}
+}
+
+// Get number of elements in use
+func (p *Pool[T]) Len() int {
+	return p.Cap() - p.free.Len()
+}
+
+// Get number of elements available without allocation of new slab
+func (p *Pool[T]) Free() int {
+	return p.free.Len()
+}
+
+// Get total number of elements allocated by Pool
+func (p *Pool[T]) Cap() int {
+	return len(p.slabs) * p.slabSize
+}
+
+// Ensure that at least count elements can be taken without allocation
+func (p *Pool[T]) Reserve(count int) {
+	for p.free.Len() < count {
+		p.grow()
+	}
+}
+
+// Take unused element from Pool allocating new slab if there are no free elements.
+//
+// Element keeps the state it had when it was put back, only its slist hook is reset
+func (p *Pool[T]) Get() (element *T) {
+	if p.free.Empty() {
+		p.grow()
+	}
+	element = p.free.PopFront()
+	if p.generationFunc != nil {
+		*p.generationFunc(element)++
+	}
+	return
+}
+
+// Return element taken by Get back to Pool. Element MUST NOT be used after this call
+// and SHOULD be unlinked from all other containers before it
+func (p *Pool[T]) Put(element *T) {
+	if p.generationFunc != nil {
+		generation := p.generationFunc(element)
+		if *generation%2 == 0 {
+			panic(fmt.Errorf("%w: Pool %p element: %p", ErrDoublePut, p, element))
+		}
+		*generation++
+	}
+	p.free.PushFront(element)
+}
+
+// Create reference to element in use that becomes invalid when element is put back
+func (p *Pool[T]) Ref(element *T) Ref[T] {
+	r := Ref[T]{element: element}
+	if p.generationFunc != nil {
+		r.generation = *p.generationFunc(element)
+	}
+	return r
+}
+
+// Return element of reference or nil if element was put back after reference was created.
+// References are never invalidated by Pool that does not track generations
+func (p *Pool[T]) Deref(r Ref[T]) *T {
+	if r.element == nil || p.generationFunc != nil && *p.generationFunc(r.element) != r.generation {
+		return nil
+	}
+	return r.element
+}
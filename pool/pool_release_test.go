@@ -0,0 +1,16 @@
+//go:build !debug
+
+package pool
+
+import (
+	"testing"
+)
+
+func TestPoolGetPutDoNotAllocate(t *testing.T) {
+	p := NewWithGeneration(16, freeHook, itemGeneration)
+	if allocs := testing.AllocsPerRun(1000, func() {
+		p.Put(p.Get())
+	}); allocs != 0 {
+		t.Errorf("get-put allocated %v times per run", allocs)
+	}
+}
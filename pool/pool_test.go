@@ -0,0 +1,142 @@
+package pool
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+type testItem struct {
+	free       slist.Hook[testItem]
+	used       dlist.Hook[testItem]
+	generation Generation
+	value      int
+}
+
+func freeHook(self *testItem) *slist.Hook[testItem] {
+	return &self.free
+}
+
+func usedHook(self *testItem) *dlist.Hook[testItem] {
+	return &self.used
+}
+
+func itemGeneration(self *testItem) *Generation {
+	return &self.generation
+}
+
+func expectPanic(t *testing.T, target error, f func()) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, target) {
+			t.Errorf("expected panic with %v, got %v", target, r)
+		}
+	}()
+	f()
+}
+
+func TestPoolGetPut(t *testing.T) {
+	p := New(4, freeHook)
+	if p.Cap() != 4 || p.Free() != 4 || p.Len() != 0 {
+		t.Fatalf("new pool has cap %d free %d len %d", p.Cap(), p.Free(), p.Len())
+	}
+	elements := make(map[*testItem]bool)
+	for i := 0; i < 6; i++ {
+		e := p.Get()
+		if e == nil || elements[e] {
+			t.Fatalf("get %d returned %v", i, e)
+		}
+		elements[e] = true
+	}
+	if p.Cap() != 8 || p.Len() != 6 || p.Free() != 2 {
+		t.Errorf("pool has cap %d len %d free %d after growth", p.Cap(), p.Len(), p.Free())
+	}
+	for e := range elements {
+		p.Put(e)
+	}
+	if p.Len() != 0 || p.Free() != 8 {
+		t.Errorf("pool has len %d free %d after all elements are put", p.Len(), p.Free())
+	}
+	if e := p.Get(); !elements[e] || p.Cap() != 8 {
+		t.Errorf("pool does not reuse put elements")
+	}
+}
+
+func TestPoolElementsCarryOtherHooks(t *testing.T) {
+	p := New(8, freeHook)
+	used := dlist.New(usedHook)
+	for i := 0; i < 5; i++ {
+		e := p.Get()
+		e.value = i
+		used.PushBack(e)
+	}
+	for e := used.PopFront(); e != nil; e = used.PopFront() {
+		p.Put(e)
+	}
+	if err := used.Validate(); err != nil || p.Len() != 0 {
+		t.Errorf("pool elements linked into other container are broken: %v", err)
+	}
+}
+
+func TestPoolReserve(t *testing.T) {
+	p := New(3, freeHook)
+	p.Reserve(7)
+	if p.Free() < 7 || p.Cap() != 9 {
+		t.Errorf("pool has free %d cap %d after reserve", p.Free(), p.Cap())
+	}
+	p.Reserve(1)
+	if p.Cap() != 9 {
+		t.Errorf("reserve of available elements allocated new slab")
+	}
+}
+
+func TestPoolGeneration(t *testing.T) {
+	p := NewWithGeneration(2, freeHook, itemGeneration)
+	e := p.Get()
+	r := p.Ref(e)
+	if p.Deref(r) != e {
+		t.Fatalf("reference to element in use is invalid")
+	}
+	p.Put(e)
+	if p.Deref(r) != nil {
+		t.Errorf("reference to put element is valid")
+	}
+	expectPanic(t, ErrDoublePut, func() { p.Put(e) })
+
+	if again := p.Get(); again != e || p.Deref(r) != nil || p.Deref(p.Ref(again)) != again {
+		t.Errorf("reference is valid after element reuse")
+	}
+	if p.Deref(Ref[testItem]{}) != nil {
+		t.Errorf("zero reference is valid")
+	}
+}
+
+func TestPoolWithoutGeneration(t *testing.T) {
+	p := New(2, freeHook)
+	e := p.Get()
+	r := p.Ref(e)
+	p.Put(e)
+	if p.Deref(r) != e {
+		t.Errorf("pool without generations invalidated reference")
+	}
+}
+
+func TestPoolInvalidSlabSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("pool with zero slab size is created")
+		}
+	}()
+	New(0, freeHook)
+}
+
+func BenchmarkPoolGetPut(b *testing.B) {
+	p := New(1024, freeHook)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p.Put(p.Get())
+	}
+}
@@ -0,0 +1,103 @@
+package queue
+
+import (
+	"iter"
+	"sync"
+)
+
+type (
+	// Wrapper of Queue safe for concurrent use: Push waits while queue is full
+	// and Pop waits while queue is empty. Deque is wrapped as FIFO: elements are
+	// pushed at the back and popped from the front. Blocking MUST NOT be copied after creation
+	Blocking[T any] struct {
+		mutex    sync.Mutex
+		notEmpty sync.Cond
+		notFull  sync.Cond
+		queue    Queue[T]
+		closed   bool
+	}
+)
+
+// Create new Blocking wrapper taking ownership of queue.
+// Queue MUST NOT be accessed directly after this call
+func NewBlocking[T any](queue Queue[T]) *Blocking[T] {
+	b := &Blocking[T]{queue: queue}
+	b.notEmpty.L = &b.mutex
+	b.notFull.L = &b.mutex
+	return b
+}
+
+// Get number of elements in queue
+func (b *Blocking[T]) Len() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.queue.Len()
+}
+
+// Insert element waiting while queue is full. Return false and do nothing if queue is closed
+func (b *Blocking[T]) Push(element *T) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for b.queue.Full() && !b.closed {
+		b.notFull.Wait()
+	}
+	if b.closed {
+		return false
+	}
+	b.queue.Push(element)
+	b.notEmpty.Signal()
+	return true
+}
+
+// Insert element without waiting. Return false and do nothing if queue is full or closed
+func (b *Blocking[T]) TryPush(element *T) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.closed || !b.queue.Push(element) {
+		return false
+	}
+	b.notEmpty.Signal()
+	return true
+}
+
+// Unlink and return next element waiting while queue is empty.
+// Return nil only if queue is closed and empty
+func (b *Blocking[T]) Pop() *T {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for b.queue.Empty() && !b.closed {
+		b.notEmpty.Wait()
+	}
+	return b.pop()
+}
+
+// Unlink and return next element without waiting or nil if queue is empty
+func (b *Blocking[T]) TryPop() *T {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return b.pop()
+}
+
+func (b *Blocking[T]) pop() *T {
+	element := b.queue.Pop()
+	if element != nil {
+		b.notFull.Signal()
+	}
+	return element
+}
+
+// Close queue: waiting and following Push calls return false, Pop returns remaining
+// elements and then nil instead of waiting. Close MAY be called several times
+func (b *Blocking[T]) Close() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.closed = true
+	b.notEmpty.Broadcast()
+	b.notFull.Broadcast()
+}
+
+// Return iterator that pops elements waiting for new ones until queue is closed and empty,
+// similar to range over channel. Elements that are not visited when iteration is stopped stay in queue
+func (b *Blocking[T]) Drain() iter.Seq[*T] {
+	return drain(b.Pop)
+}
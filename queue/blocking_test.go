@@ -0,0 +1,134 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingProducersConsumers(t *testing.T) {
+	const producers = 4
+	const perProducer = 500
+	items := newItems(producers * perProducer)
+	fifo := NewFIFO(sHook, 8)
+	b := NewBlocking[testItem](&fifo)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := p * perProducer; i < (p+1)*perProducer; i++ {
+				if !b.Push(&items[i]) {
+					t.Errorf("push into open queue failed")
+				}
+			}
+		}(p)
+	}
+
+	seen := make([]bool, len(items))
+	var consumers sync.WaitGroup
+	var seenMutex sync.Mutex
+	for c := 0; c < 3; c++ {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for e := range b.Drain() {
+				seenMutex.Lock()
+				if seen[e.value] {
+					t.Errorf("element %d is popped twice", e.value)
+				}
+				seen[e.value] = true
+				seenMutex.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	b.Close()
+	consumers.Wait()
+	for i, ok := range seen {
+		if !ok {
+			t.Errorf("element %d is not popped", i)
+		}
+	}
+	if b.Len() != 0 || b.Pop() != nil {
+		t.Errorf("closed queue is not empty")
+	}
+}
+
+func TestBlockingPushWaitsForSpace(t *testing.T) {
+	items := newItems(2)
+	lifo := NewLIFO(dHook, 1)
+	b := NewBlocking[testItem](&lifo)
+	if !b.TryPush(&items[0]) || b.TryPush(&items[1]) {
+		t.Fatalf("try push into bounded queue is incorrect")
+	}
+
+	pushed := make(chan bool)
+	go func() { pushed <- b.Push(&items[1]) }()
+	select {
+	case <-pushed:
+		t.Fatalf("push into full queue did not wait")
+	case <-time.After(10 * time.Millisecond):
+	}
+	if e := b.Pop(); e != &items[0] {
+		t.Errorf("pop returned %v", e)
+	}
+	if !<-pushed || b.TryPop() != &items[1] || b.TryPop() != nil {
+		t.Errorf("waiting push did not insert element after pop")
+	}
+}
+
+func TestBlockingCloseWakesWaiters(t *testing.T) {
+	items := newItems(2)
+	fifo := NewFIFO(sHook, 1)
+	b := NewBlocking[testItem](&fifo)
+
+	popped := make(chan *testItem)
+	go func() { popped <- b.Pop() }()
+	time.Sleep(10 * time.Millisecond)
+	b.Close()
+	if e := <-popped; e != nil {
+		t.Errorf("pop from closed empty queue returned %v", e)
+	}
+
+	if b.Push(&items[0]) || b.TryPush(&items[0]) {
+		t.Errorf("push into closed queue succeeded")
+	}
+	b.Close()
+}
+
+func TestBlockingCloseReleasesWaitingPush(t *testing.T) {
+	items := newItems(2)
+	fifo := NewFIFO(sHook, 1)
+	b := NewBlocking[testItem](&fifo)
+	b.Push(&items[0])
+
+	pushed := make(chan bool)
+	go func() { pushed <- b.Push(&items[1]) }()
+	time.Sleep(10 * time.Millisecond)
+	b.Close()
+	if <-pushed {
+		t.Errorf("waiting push into closed queue succeeded")
+	}
+	if e := b.Pop(); e != &items[0] || b.Pop() != nil {
+		t.Errorf("closed queue did not return remaining element")
+	}
+}
+
+func TestBlockingDeque(t *testing.T) {
+	items := newItems(3)
+	deque := NewDeque(dHook, 2)
+	b := NewBlocking[testItem](&deque)
+	if !b.Push(&items[0]) || !b.TryPush(&items[1]) || b.TryPush(&items[2]) {
+		t.Fatalf("push into bounded Deque is incorrect")
+	}
+	if e := b.Pop(); e != &items[0] {
+		t.Errorf("Deque is not popped from the front: %v", e)
+	}
+	b.Close()
+	if e := b.Pop(); e != &items[1] || b.Pop() != nil {
+		t.Errorf("closed Deque did not return remaining element")
+	}
+}
@@ -0,0 +1,107 @@
+package queue
+
+import (
+	"iter"
+
+	"github.com/echo-Mike/intrusive/dlist"
+)
+
+type (
+	// Double-ended queue over DList
+	Deque[T any] struct {
+		list     dlist.DList[T]
+		capacity int
+	}
+)
+
+// Create new Deque of elements linked by dlist hook with capacity, 0 means unbounded
+func NewDeque[T any](hookFunc func(*T) *dlist.Hook[T], capacity int) Deque[T] {
+	checkCapacity(capacity)
+	return Deque[T]{list: dlist.New(hookFunc), capacity: capacity}
+}
+
+// Get number of elements in Deque
+func (q *Deque[T]) Len() int {
+	return q.list.Len()
+}
+
+// Get capacity of Deque, 0 means unbounded
+func (q *Deque[T]) Cap() int {
+	return q.capacity
+}
+
+// Check if Deque is empty
+func (q *Deque[T]) Empty() bool {
+	return q.list.Empty()
+}
+
+// Check if Deque is bounded and has no free space
+func (q *Deque[T]) Full() bool {
+	return q.capacity != 0 && q.list.Len() >= q.capacity
+}
+
+// Insert element at the front of Deque. Return false and do nothing if Deque is full
+func (q *Deque[T]) PushFront(element *T) bool {
+	if q.Full() {
+		return false
+	}
+	q.list.PushFront(element)
+	return true
+}
+
+// Insert element at the back of Deque. Return false and do nothing if Deque is full
+func (q *Deque[T]) PushBack(element *T) bool {
+	if q.Full() {
+		return false
+	}
+	q.list.PushBack(element)
+	return true
+}
+
+// Insert element at the back of Deque, same as PushBack.
+// Together with Pop it makes Deque a Queue in FIFO order, so it can be wrapped by Blocking
+func (q *Deque[T]) Push(element *T) bool {
+	return q.PushBack(element)
+}
+
+// Unlink and return element at the front of Deque or nil if Deque is empty, same as PopFront
+func (q *Deque[T]) Pop() *T {
+	return q.PopFront()
+}
+
+// Unlink and return element at the front of Deque or nil if Deque is empty
+func (q *Deque[T]) PopFront() *T {
+	return q.list.PopFront()
+}
+
+// Unlink and return element at the back of Deque or nil if Deque is empty
+func (q *Deque[T]) PopBack() *T {
+	return q.list.PopBack()
+}
+
+// Return element at the front of Deque without unlinking it
+func (q *Deque[T]) Front() *T {
+	return q.list.Front()
+}
+
+// Return element at the back of Deque without unlinking it
+func (q *Deque[T]) Back() *T {
+	return q.list.Back()
+}
+
+// Unlink element from any position of Deque. Element SHOULD be part of Deque
+func (q *Deque[T]) Erase(element *T) {
+	q.list.Erase(element)
+}
+
+// Return iterator that pops elements from the front of Deque until Deque is empty.
+// Elements that are not visited when iteration is stopped stay in Deque
+func (q *Deque[T]) Drain() iter.Seq[*T] {
+	return drain(q.PopFront)
+}
+
+// Return iterator that pops elements from the back of Deque until Deque is empty.
+// Elements that are not visited when iteration is stopped stay in Deque
+func (q *Deque[T]) DrainBack() iter.Seq[*T] {
+	return drain(q.PopBack)
+}
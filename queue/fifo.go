@@ -0,0 +1,66 @@
+package queue
+
+import (
+	"iter"
+
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+type (
+	// First-in first-out queue over SList
+	FIFO[T any] struct {
+		list     slist.SList[T]
+		capacity int
+	}
+)
+
+// Create new FIFO of elements linked by slist hook with capacity, 0 means unbounded
+func NewFIFO[T any](hookFunc func(*T) *slist.Hook[T], capacity int) FIFO[T] {
+	checkCapacity(capacity)
+	return FIFO[T]{list: slist.New(hookFunc), capacity: capacity}
+}
+
+// Get number of elements in FIFO
+func (q *FIFO[T]) Len() int {
+	return q.list.Len()
+}
+
+// Get capacity of FIFO, 0 means unbounded
+func (q *FIFO[T]) Cap() int {
+	return q.capacity
+}
+
+// Check if FIFO is empty
+func (q *FIFO[T]) Empty() bool {
+	return q.list.Empty()
+}
+
+// Check if FIFO is bounded and has no free space
+func (q *FIFO[T]) Full() bool {
+	return q.capacity != 0 && q.list.Len() >= q.capacity
+}
+
+// Insert element at the back of FIFO. Return false and do nothing if FIFO is full
+func (q *FIFO[T]) Push(element *T) bool {
+	if q.Full() {
+		return false
+	}
+	q.list.PushBack(element)
+	return true
+}
+
+// Unlink and return element at the front of FIFO or nil if FIFO is empty
+func (q *FIFO[T]) Pop() *T {
+	return q.list.PopFront()
+}
+
+// Return element at the front of FIFO without unlinking it
+func (q *FIFO[T]) Peek() *T {
+	return q.list.Front()
+}
+
+// Return iterator that pops elements in FIFO order until FIFO is empty.
+// Elements that are not visited when iteration is stopped stay in FIFO
+func (q *FIFO[T]) Drain() iter.Seq[*T] {
+	return drain(q.Pop)
+}
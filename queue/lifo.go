@@ -0,0 +1,71 @@
+package queue
+
+import (
+	"iter"
+
+	"github.com/echo-Mike/intrusive/dlist"
+)
+
+type (
+	// Last-in first-out stack over DList
+	LIFO[T any] struct {
+		list     dlist.DList[T]
+		capacity int
+	}
+)
+
+// Create new LIFO of elements linked by dlist hook with capacity, 0 means unbounded
+func NewLIFO[T any](hookFunc func(*T) *dlist.Hook[T], capacity int) LIFO[T] {
+	checkCapacity(capacity)
+	return LIFO[T]{list: dlist.New(hookFunc), capacity: capacity}
+}
+
+// Get number of elements in LIFO
+func (q *LIFO[T]) Len() int {
+	return q.list.Len()
+}
+
+// Get capacity of LIFO, 0 means unbounded
+func (q *LIFO[T]) Cap() int {
+	return q.capacity
+}
+
+// Check if LIFO is empty
+func (q *LIFO[T]) Empty() bool {
+	return q.list.Empty()
+}
+
+// Check if LIFO is bounded and has no free space
+func (q *LIFO[T]) Full() bool {
+	return q.capacity != 0 && q.list.Len() >= q.capacity
+}
+
+// Insert element at the top of LIFO. Return false and do nothing if LIFO is full
+func (q *LIFO[T]) Push(element *T) bool {
+	if q.Full() {
+		return false
+	}
+	q.list.PushBack(element)
+	return true
+}
+
+// Unlink and return element at the top of LIFO or nil if LIFO is empty
+func (q *LIFO[T]) Pop() *T {
+	return q.list.PopBack()
+}
+
+// Return element at the top of LIFO without unlinking it
+func (q *LIFO[T]) Peek() *T {
+	return q.list.Back()
+}
+
+// Unlink element from any position of LIFO. Element SHOULD be part of LIFO
+func (q *LIFO[T]) Erase(element *T) {
+	q.list.Erase(element)
+}
+
+// Return iterator that pops elements in LIFO order until LIFO is empty.
+// Elements that are not visited when iteration is stopped stay in LIFO
+func (q *LIFO[T]) Drain() iter.Seq[*T] {
+	return drain(q.Pop)
+}
@@ -0,0 +1,40 @@
+// Package queue implements queue adapters over intrusive lists: FIFO over SList,
+// LIFO and Deque over DList, and Blocking wrapper that makes any of them safe
+// for concurrent use.
+//
+// Every adapter MAY be bounded by capacity: Push into full adapter does nothing
+// and returns false. Capacity 0 means unbounded adapter
+package queue
+
+import (
+	"fmt"
+	"iter"
+)
+
+type (
+	// Queue is implemented by FIFO, LIFO and Deque in FIFO order and is wrapped by Blocking
+	Queue[T any] interface {
+		Push(element *T) bool
+		Pop() *T
+		Len() int
+		Empty() bool
+		Full() bool
+	}
+)
+
+func checkCapacity(capacity int) {
+	if capacity < 0 {
+		panic(fmt.Sprintf("queue: capacity must not be negative: %d", capacity))
+	}
+}
+
+// Return iterator that pops elements with pop until it returns nil or iteration is stopped
+func drain[T any](pop func() *T) iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for e := pop(); e != nil; e = pop() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/dlist"
+	"github.com/echo-Mike/intrusive/slist"
+)
+
+type testItem struct {
+	sHook slist.Hook[testItem]
+	dHook dlist.Hook[testItem]
+	value int
+}
+
+func sHook(self *testItem) *slist.Hook[testItem] {
+	return &self.sHook
+}
+
+func dHook(self *testItem) *dlist.Hook[testItem] {
+	return &self.dHook
+}
+
+func newItems(count int) []testItem {
+	items := make([]testItem, count)
+	for i := range items {
+		items[i].value = i
+	}
+	return items
+}
+
+func values(it func(yield func(*testItem) bool)) []int {
+	v := make([]int, 0)
+	for e := range it {
+		v = append(v, e.value)
+	}
+	return v
+}
+
+var (
+	_ Queue[testItem] = &FIFO[testItem]{}
+	_ Queue[testItem] = &LIFO[testItem]{}
+	_ Queue[testItem] = &Deque[testItem]{}
+)
+
+func TestFIFO(t *testing.T) {
+	items := newItems(5)
+	q := NewFIFO(sHook, 0)
+	if !q.Empty() || q.Full() || q.Pop() != nil || q.Peek() != nil || q.Cap() != 0 {
+		t.Fatalf("new FIFO is not empty")
+	}
+	for i := range items {
+		if !q.Push(&items[i]) {
+			t.Fatalf("push into unbounded FIFO failed")
+		}
+	}
+	if q.Len() != 5 || q.Peek() != &items[0] {
+		t.Errorf("FIFO has size %d and front %v", q.Len(), q.Peek())
+	}
+	if e := q.Pop(); e != &items[0] {
+		t.Errorf("FIFO popped %v instead of first element", e)
+	}
+	if v := values(q.Drain()); !slices.Equal(v, []int{1, 2, 3, 4}) || !q.Empty() {
+		t.Errorf("FIFO drained %v", v)
+	}
+}
+
+func TestLIFO(t *testing.T) {
+	items := newItems(5)
+	q := NewLIFO(dHook, 0)
+	if !q.Empty() || q.Full() || q.Pop() != nil || q.Peek() != nil || q.Cap() != 0 {
+		t.Fatalf("new LIFO is not empty")
+	}
+	for i := range items {
+		q.Push(&items[i])
+	}
+	if q.Len() != 5 || q.Peek() != &items[4] {
+		t.Errorf("LIFO has size %d and top %v", q.Len(), q.Peek())
+	}
+	q.Erase(&items[2])
+	if e := q.Pop(); e != &items[4] {
+		t.Errorf("LIFO popped %v instead of last element", e)
+	}
+	if v := values(q.Drain()); !slices.Equal(v, []int{3, 1, 0}) || !q.Empty() {
+		t.Errorf("LIFO drained %v", v)
+	}
+}
+
+func TestDeque(t *testing.T) {
+	items := newItems(6)
+	q := NewDeque(dHook, 0)
+	if !q.Empty() || q.Full() || q.PopFront() != nil || q.PopBack() != nil || q.Front() != nil || q.Back() != nil || q.Cap() != 0 {
+		t.Fatalf("new Deque is not empty")
+	}
+	for i := 0; i < 3; i++ {
+		q.PushBack(&items[i])
+		q.PushFront(&items[i+3])
+	}
+	if q.Len() != 6 || q.Front() != &items[5] || q.Back() != &items[2] {
+		t.Errorf("Deque has size %d front %v back %v", q.Len(), q.Front(), q.Back())
+	}
+	q.Erase(&items[0])
+	if q.PopFront() != &items[5] || q.PopBack() != &items[2] {
+		t.Errorf("Deque popped wrong elements")
+	}
+	if v := values(q.DrainBack()); !slices.Equal(v, []int{1, 3, 4}) || !q.Empty() {
+		t.Errorf("Deque drained from back %v", v)
+	}
+	q.PushBack(&items[0])
+	q.PushBack(&items[1])
+	if v := values(q.Drain()); !slices.Equal(v, []int{0, 1}) {
+		t.Errorf("Deque drained %v", v)
+	}
+	if !q.Push(&items[2]) || !q.Push(&items[3]) || q.Pop() != &items[2] || q.Pop() != &items[3] || q.Pop() != nil {
+		t.Errorf("Deque as Queue is not FIFO")
+	}
+}
+
+func TestCapacity(t *testing.T) {
+	items := newItems(4)
+	f, l, d := NewFIFO(sHook, 2), NewLIFO(dHook, 2), NewDeque(dHook, 3)
+	for i := 0; i < 2; i++ {
+		if !f.Push(&items[i]) || !l.Push(&items[i]) {
+			t.Fatalf("push into not full queue failed")
+		}
+	}
+	if !f.Full() || !l.Full() || f.Push(&items[2]) || l.Push(&items[2]) || f.Len() != 2 || l.Len() != 2 || f.Cap() != 2 || l.Cap() != 2 {
+		t.Errorf("bounded FIFO or LIFO accepted element over capacity")
+	}
+
+	dequeItems := newItems(4)
+	if !d.PushBack(&dequeItems[0]) || !d.PushFront(&dequeItems[1]) || !d.PushBack(&dequeItems[2]) {
+		t.Fatalf("push into not full Deque failed")
+	}
+	if !d.Full() || d.PushBack(&dequeItems[3]) || d.PushFront(&dequeItems[3]) || d.Len() != 3 || d.Cap() != 3 {
+		t.Errorf("bounded Deque accepted element over capacity")
+	}
+	d.PopBack()
+	if d.Full() || !d.PushFront(&dequeItems[3]) {
+		t.Errorf("bounded Deque does not accept element after pop")
+	}
+}
+
+func TestNegativeCapacityPanics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"fifo":  func() { NewFIFO(sHook, -1) },
+		"lifo":  func() { NewLIFO(dHook, -1) },
+		"deque": func() { NewDeque(dHook, -1) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("queue with negative capacity is created")
+				}
+			}()
+			f()
+		})
+	}
+}
+
+func TestDrainStopsEarly(t *testing.T) {
+	items := newItems(4)
+	q := NewFIFO(sHook, 0)
+	for i := range items {
+		q.Push(&items[i])
+	}
+	for e := range q.Drain() {
+		if e.value == 1 {
+			break
+		}
+	}
+	if q.Len() != 2 || q.Peek() != &items[2] {
+		t.Errorf("FIFO has size %d after stopped drain", q.Len())
+	}
+}
@@ -0,0 +1,411 @@
+// Package radix implements intrusive crit-bit tree: a compressed binary radix tree
+// of elements keyed by byte strings. Tree supports exact and longest-prefix match,
+// iteration over keys with a prefix and traversal in lexicographical order of keys.
+//
+// Tree of n elements has n-1 branch nodes and each Hook contains room for one of
+// them, so no memory is allocated by tree operations. A branch node is not bound to
+// the element that hosts it: when element is erased its branch node is moved into
+// the freed room of another element.
+//
+// Every byte of a key is treated as 9-bit symbol with the highest bit set and end
+// of a key is treated as zero symbol, so a key is ordered before keys it is prefix of
+package radix
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// Mask of symbol bit that distinguishes end of a key from a byte
+const endMask = 0x100
+
+type (
+	// Key is a type of keys of Tree
+	Key interface {
+		~string | ~[]byte
+	}
+
+	// Branch node of a tree. Child is either an element itself (a leaf) or
+	// a branch node hosted by the element
+	branch[T any] struct {
+		// Host of parent branch node or nil if branch node is the root
+		parent *T
+		child  [2]*T
+		leaf   [2]bool
+		// Position of critical bit: byte index and mask of bit in 9-bit symbol
+		index int
+		mask  uint16
+		used  bool
+	}
+
+	// Hook structure to insert/embed into concrete types
+	// of elements of radix tree
+	Hook[T any] struct {
+		// Host of branch node which has element as leaf child
+		parent *T
+		branch branch[T]
+	}
+
+	// Tree implements crit-bit tree of elements with unique keys.
+	// Key of an element MUST NOT change while it is inside tree
+	Tree[K Key, T any] struct {
+		hookFunc func(*T) *Hook[T]
+		keyFunc  func(*T) K
+		root     *T
+		rootLeaf bool
+		size     int
+	}
+)
+
+// Initialize hook to empty state.
+//
+// WARNING: Calling this function on linked Hook will damage Tree structure
+func (h *Hook[T]) Init() {
+	*h = Hook[T]{}
+}
+
+// NewHook creates a new initialized Hook
+func NewHook[T any]() Hook[T] {
+	return Hook[T]{}
+}
+
+// New creates a new Tree of elements identified by key returned by keyFunc
+func New[K Key, T any](hookFunc func(*T) *Hook[T], keyFunc func(*T) K) Tree[K, T] {
+	return Tree[K, T]{hookFunc: hookFunc, keyFunc: keyFunc}
+}
+
+// Return symbol of key at index: byte with endMask set or zero after end of key
+func symbol[K Key](key K, index int) uint16 {
+	if index < len(key) {
+		return endMask | uint16(key[index])
+	}
+	return 0
+}
+
+// Return side of branch node that key belongs to
+func direction[K Key](key K, index int, mask uint16) int {
+	if symbol(key, index)&mask == 0 {
+		return 0
+	}
+	return 1
+}
+
+// Return position of the first bit that differs in lhs and rhs. Return false if keys are equal
+func critBit[K Key](lhs, rhs K) (index int, mask uint16, ok bool) {
+	for index = 0; index <= max(len(lhs), len(rhs)); index++ {
+		if diff := symbol(lhs, index) ^ symbol(rhs, index); diff != 0 {
+			return index, 1 << (bits.Len16(diff) - 1), true
+		}
+	}
+	return 0, 0, false
+}
+
+// Check if key starts with prefix
+func hasPrefix[K Key](key, prefix K) bool {
+	if len(key) < len(prefix) {
+		return false
+	}
+	for i := range len(prefix) {
+		if key[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Tree[K, T]) branch(host *T) *branch[T] {
+	return &t.hookFunc(host).branch
+}
+
+// Return side of parent branch node hosted by parent that node is linked to
+func (t *Tree[K, T]) side(parent *T, node *T, leaf bool) int {
+	if b := t.branch(parent); b.child[1] == node && b.leaf[1] == leaf {
+		return 1
+	}
+	return 0
+}
+
+// Set parent of leaf or branch node
+func (t *Tree[K, T]) setParent(node *T, leaf bool, parent *T) {
+	if leaf {
+		t.hookFunc(node).parent = parent
+	} else {
+		t.branch(node).parent = parent
+	}
+}
+
+// Link node as child of branch node hosted by parent or as root if parent is nil
+func (t *Tree[K, T]) link(parent *T, side int, node *T, leaf bool) {
+	if parent == nil {
+		t.root, t.rootLeaf = node, leaf
+	} else {
+		b := t.branch(parent)
+		b.child[side], b.leaf[side] = node, leaf
+	}
+	t.setParent(node, leaf, parent)
+}
+
+// Return the first leaf of subtree
+func (t *Tree[K, T]) first(node *T, leaf bool) *T {
+	for !leaf {
+		b := t.branch(node)
+		node, leaf = b.child[0], b.leaf[0]
+	}
+	return node
+}
+
+// Return the last leaf of subtree
+func (t *Tree[K, T]) last(node *T, leaf bool) *T {
+	for !leaf {
+		b := t.branch(node)
+		node, leaf = b.child[1], b.leaf[1]
+	}
+	return node
+}
+
+// Return leaf that shares the longest path with key. Tree MUST NOT be empty
+func (t *Tree[K, T]) closest(key K) *T {
+	node, leaf := t.root, t.rootLeaf
+	for !leaf {
+		b := t.branch(node)
+		d := direction(key, b.index, b.mask)
+		node, leaf = b.child[d], b.leaf[d]
+	}
+	return node
+}
+
+// Empty checks if tree has no elements
+func (t *Tree[K, T]) Empty() bool {
+	return t.size == 0
+}
+
+// Size returns number of elements in tree
+func (t *Tree[K, T]) Size() int {
+	return t.size
+}
+
+// Len returns number of elements in tree
+func (t *Tree[K, T]) Len() int {
+	return t.size
+}
+
+// Front returns element with the smallest key
+func (t *Tree[K, T]) Front() *T {
+	if t.root == nil {
+		return nil
+	}
+	return t.first(t.root, t.rootLeaf)
+}
+
+// Back returns element with the largest key
+func (t *Tree[K, T]) Back() *T {
+	if t.root == nil {
+		return nil
+	}
+	return t.last(t.root, t.rootLeaf)
+}
+
+// Next returns element with the next key in order
+func (t *Tree[K, T]) Next(element *T) *T {
+	node, leaf := element, true
+	for parent := t.hookFunc(element).parent; parent != nil; parent = t.branch(parent).parent {
+		if b := t.branch(parent); t.side(parent, node, leaf) == 0 {
+			return t.first(b.child[1], b.leaf[1])
+		}
+		node, leaf = parent, false
+	}
+	return nil
+}
+
+// Prev returns element with the previous key in order
+func (t *Tree[K, T]) Prev(element *T) *T {
+	node, leaf := element, true
+	for parent := t.hookFunc(element).parent; parent != nil; parent = t.branch(parent).parent {
+		if b := t.branch(parent); t.side(parent, node, leaf) == 1 {
+			return t.last(b.child[0], b.leaf[0])
+		}
+		node, leaf = parent, false
+	}
+	return nil
+}
+
+// Insert adds element if tree has no element with the same key. Return true if element was inserted
+func (t *Tree[K, T]) Insert(element *T) bool {
+	if element == nil {
+		return false
+	}
+	t.verifyElementNotLinked(element)
+	defer t.verify()
+
+	key := t.keyFunc(element)
+	hook := t.hookFunc(element)
+	if t.root == nil {
+		t.link(nil, 0, element, true)
+		t.size++
+		return true
+	}
+	index, mask, ok := critBit(key, t.keyFunc(t.closest(key)))
+	if !ok {
+		return false
+	}
+
+	// Find the first node with critical bit after the new one
+	var parent *T
+	side := 0
+	node, leaf := t.root, t.rootLeaf
+	for !leaf {
+		b := t.branch(node)
+		if b.index > index || b.index == index && b.mask < mask {
+			break
+		}
+		parent, side = node, direction(key, b.index, b.mask)
+		node, leaf = b.child[side], b.leaf[side]
+	}
+
+	// New branch node is hosted by inserted element
+	d := direction(key, index, mask)
+	hook.branch = branch[T]{index: index, mask: mask, used: true}
+	t.link(element, d, element, true)
+	t.link(element, 1-d, node, leaf)
+	t.link(parent, side, element, false)
+	t.size++
+	return true
+}
+
+// Erase removes element from tree. Return false if element is not part of tree
+func (t *Tree[K, T]) Erase(element *T) bool {
+	if element == nil || t.root == nil || t.closest(t.keyFunc(element)) != element {
+		return false
+	}
+	defer t.verify()
+
+	hook := t.hookFunc(element)
+	if t.rootLeaf {
+		t.root, t.rootLeaf = nil, false
+		hook.Init()
+		t.size--
+		return true
+	}
+
+	// Replace parent branch node with sibling of element
+	host := hook.parent
+	b := t.branch(host)
+	sibling := 1 - t.side(host, element, true)
+	grand := b.parent
+	grandSide := 0
+	if grand != nil {
+		grandSide = t.side(grand, host, false)
+	}
+	t.link(grand, grandSide, b.child[sibling], b.leaf[sibling])
+	*b = branch[T]{}
+
+	// Move branch node hosted by element into room freed by parent branch node
+	if host != element && hook.branch.used {
+		moved := hook.branch
+		*b = moved
+		movedSide := 0
+		if moved.parent != nil {
+			movedSide = t.side(moved.parent, element, false)
+		}
+		t.link(moved.parent, movedSide, host, false)
+		t.setParent(moved.child[0], moved.leaf[0], host)
+		t.setParent(moved.child[1], moved.leaf[1], host)
+	}
+	hook.Init()
+	t.size--
+	return true
+}
+
+// Find searches for an element with key
+func (t *Tree[K, T]) Find(key K) *T {
+	if t.root == nil {
+		return nil
+	}
+	element := t.closest(key)
+	if other := t.keyFunc(element); len(other) != len(key) || !hasPrefix(other, key) {
+		return nil
+	}
+	return element
+}
+
+// Contains checks if tree has an element with key
+func (t *Tree[K, T]) Contains(key K) bool {
+	return t.Find(key) != nil
+}
+
+// LongestPrefix searches for an element with the longest key that is prefix of key
+func (t *Tree[K, T]) LongestPrefix(key K) *T {
+	if t.root == nil {
+		return nil
+	}
+	var found *T
+	node, leaf := t.root, t.rootLeaf
+	for !leaf {
+		b := t.branch(node)
+		// Left side of branch node at end of a key shorter than key is a single element
+		if b.mask == endMask && b.index < len(key) && hasPrefix(key, t.keyFunc(b.child[0])) {
+			found = b.child[0]
+		}
+		d := direction(key, b.index, b.mask)
+		node, leaf = b.child[d], b.leaf[d]
+	}
+	if hasPrefix(key, t.keyFunc(node)) {
+		return node
+	}
+	return found
+}
+
+// WithPrefix returns iterator over elements with keys that start with prefix in order.
+// Loop body MAY erase its argument but MUST NOT insert elements into tree
+func (t *Tree[K, T]) WithPrefix(prefix K) iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		if t.root == nil {
+			return
+		}
+		// Keys of subtree share all bits before critical bit of its root
+		node, leaf := t.root, t.rootLeaf
+		for !leaf {
+			b := t.branch(node)
+			if b.index >= len(prefix) {
+				break
+			}
+			d := direction(prefix, b.index, b.mask)
+			node, leaf = b.child[d], b.leaf[d]
+		}
+		first, last := t.first(node, leaf), t.last(node, leaf)
+		if !hasPrefix(t.keyFunc(first), prefix) {
+			return
+		}
+		for e := first; e != nil; {
+			var next *T
+			if e != last {
+				next = t.Next(e)
+			}
+			if !yield(e) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Traverse calls f for every element in order of keys. F MAY erase its argument
+func (t *Tree[K, T]) Traverse(f func(*T)) {
+	for e := t.Front(); e != nil; {
+		next := t.Next(e)
+		f(e)
+		e = next
+	}
+}
+
+// Clear removes all elements from tree and returns them in order of keys
+func (t *Tree[K, T]) Clear() []*T {
+	elements := make([]*T, 0, t.size)
+	t.Traverse(func(e *T) { elements = append(elements, e) })
+	for _, e := range elements {
+		t.hookFunc(e).Init()
+	}
+	t.root, t.rootLeaf = nil, false
+	t.size = 0
+	return elements
+}
@@ -0,0 +1,132 @@
+package radix
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/oracle"
+)
+
+const (
+	oracleOpInsert byte = iota
+	oracleOpErase
+	oracleOpFind
+	oracleOpLongestPrefix
+	oracleOpWithPrefix
+	oracleOpClear
+	oracleOpCOUNT
+)
+
+// Keys over small alphabet with zero byte so that many keys are prefixes of each other
+func fuzzKey(n int) string {
+	const alphabet = "a\x00\xff"
+	var key strings.Builder
+	for ; n > 0; n /= 4 {
+		key.WriteByte(alphabet[n%4%len(alphabet)])
+	}
+	return key.String()
+}
+
+func lessFuzz(lhs, rhs *testItem) bool {
+	return lhs.key < rhs.key
+}
+
+// Apply command to Tree and to its reference model
+func oracleStep(t *testing.T, items []testItem, keys []string, tree *Tree[string, testItem], m *oracle.Set[*testItem]) func(command []byte) {
+	return func(command []byte) {
+		op, arg1, arg2 := command[0], int(command[1]), int(command[2])
+		item := &items[arg1%len(items)]
+		key := keys[arg2%len(keys)]
+
+		switch op % oracleOpCOUNT {
+		case oracleOpInsert:
+			if item.hook == NewHook[testItem]() && tree.Front() != item {
+				if tree.Insert(item) != m.Insert(item) {
+					t.Fatalf("insert of %q differs from model", item.key)
+				}
+			}
+
+		case oracleOpErase:
+			if tree.Erase(item) != m.Erase(item) {
+				t.Fatalf("erase of %q differs from model", item.key)
+			}
+
+		case oracleOpFind:
+			if got, want := tree.Find(key), m.Find(&testItem{key: key}); got != want {
+				t.Fatalf("Find(%q) = %v, model has %v", key, got, want)
+			}
+
+		case oracleOpLongestPrefix:
+			var want *testItem
+			for _, e := range m.Elements() {
+				if strings.HasPrefix(key, e.key) {
+					want = e
+				}
+			}
+			if got := tree.LongestPrefix(key); got != want {
+				t.Fatalf("LongestPrefix(%q) = %v, model has %v", key, got, want)
+			}
+
+		case oracleOpWithPrefix:
+			var want []*testItem
+			for _, e := range m.Elements() {
+				if strings.HasPrefix(e.key, key) {
+					want = append(want, e)
+				}
+			}
+			got := slices.Collect(tree.WithPrefix(key))
+			if diff := oracle.Diff(got, want); diff != "" {
+				t.Fatalf("WithPrefix(%q) differs from model: %s", key, diff)
+			}
+
+		case oracleOpClear:
+			if diff := oracle.Diff(tree.Clear(), m.Clear()); diff != "" {
+				t.Fatalf("removed elements differ from model: %s", diff)
+			}
+		}
+	}
+}
+
+// Compare full contents of Tree with its reference model in both directions
+func oracleCheck(t *testing.T, tree *Tree[string, testItem], m *oracle.Set[*testItem]) func(step int) {
+	return func(step int) {
+		want := m.Elements()
+		forward := oracle.Collect(tree.Front(), tree.Next)
+		if diff := oracle.Diff(forward, want); diff != "" {
+			t.Fatalf("step %d: tree differs from model: %s", step, diff)
+		}
+		backward := oracle.Collect(tree.Back(), tree.Prev)
+		slices.Reverse(backward)
+		if diff := oracle.Diff(backward, want); diff != "" {
+			t.Fatalf("step %d: tree in reverse differs from model: %s", step, diff)
+		}
+		if tree.Len() != len(want) {
+			t.Fatalf("step %d: tree has size %d while model has %d", step, tree.Len(), len(want))
+		}
+	}
+}
+
+func FuzzTreeOracle(f *testing.F) {
+	const numItems = 96
+	const numKeys = 128
+
+	for _, seed := range oracle.Seeds(32, 512) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, commands []byte) {
+		keys := make([]string, numKeys)
+		for i := range keys {
+			keys[i] = fuzzKey(i)
+		}
+		items := make([]testItem, numItems)
+		for i := range items {
+			items[i] = testItem{hook: NewHook[testItem](), key: keys[i%64]}
+		}
+		tree := New(testHook, testKey)
+		m := oracle.NewSet(lessFuzz)
+
+		oracle.Replay(commands, 3, oracleStep(t, items, keys, &tree, &m), oracleCheck(t, &tree, &m))
+	})
+}
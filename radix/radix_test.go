@@ -0,0 +1,249 @@
+package radix
+
+import (
+	"slices"
+	"testing"
+)
+
+type testItem struct {
+	hook Hook[testItem]
+	key  string
+}
+
+func testHook(self *testItem) *Hook[testItem] {
+	return &self.hook
+}
+
+func testKey(self *testItem) string {
+	return self.key
+}
+
+func newTestTree(keys ...string) (Tree[string, testItem], []testItem) {
+	tree := New(testHook, testKey)
+	items := make([]testItem, len(keys))
+	for i, key := range keys {
+		items[i] = testItem{hook: NewHook[testItem](), key: key}
+		tree.Insert(&items[i])
+	}
+	return tree, items
+}
+
+func treeKeys(tree *Tree[string, testItem]) (keys []string) {
+	tree.Traverse(func(e *testItem) { keys = append(keys, e.key) })
+	return
+}
+
+func seqKeys(seq func(func(*testItem) bool)) (keys []string) {
+	for e := range seq {
+		keys = append(keys, e.key)
+	}
+	return
+}
+
+/// Tree
+
+func TestTreeInsert(t *testing.T) {
+	tests := map[string]struct {
+		keys []string
+		want []string
+	}{
+		"empty":      {nil, nil},
+		"single":     {[]string{"a"}, []string{"a"}},
+		"empty key":  {[]string{"b", "", "a"}, []string{"", "a", "b"}},
+		"prefixes":   {[]string{"abc", "a", "ab", "abcd"}, []string{"a", "ab", "abc", "abcd"}},
+		"zero bytes": {[]string{"a\x00", "a", "a\x00\x00", "\x00"}, []string{"\x00", "a", "a\x00", "a\x00\x00"}},
+		"duplicates": {[]string{"x", "y", "x", "y"}, []string{"x", "y"}},
+		"high bytes": {[]string{"\xff", "\x80", "\x7f", "\x01"}, []string{"\x01", "\x7f", "\x80", "\xff"}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tree, _ := newTestTree(tt.keys...)
+			if got := treeKeys(&tree); !slices.Equal(got, tt.want) {
+				t.Errorf("tree has keys %q, want %q", got, tt.want)
+			}
+			if tree.Len() != len(tt.want) || tree.Size() != len(tt.want) || tree.Empty() != (len(tt.want) == 0) {
+				t.Errorf("tree has size %d, want %d", tree.Len(), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestTreeInsertDuplicate(t *testing.T) {
+	tree, items := newTestTree("a", "b")
+	other := testItem{hook: NewHook[testItem](), key: "a"}
+	if tree.Insert(&other) || tree.Find("a") != &items[0] {
+		t.Errorf("element with duplicate key inserted")
+	}
+	if other.hook != NewHook[testItem]() || tree.Insert(nil) {
+		t.Errorf("hook of rejected element changed or nil inserted")
+	}
+}
+
+func TestTreeFind(t *testing.T) {
+	tree, items := newTestTree("romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus")
+	for i := range items {
+		if tree.Find(items[i].key) != &items[i] || !tree.Contains(items[i].key) {
+			t.Errorf("element %q not found", items[i].key)
+		}
+	}
+	for _, key := range []string{"", "r", "roman", "romanes", "rubicundu", "zeta"} {
+		if tree.Contains(key) {
+			t.Errorf("tree contains %q", key)
+		}
+	}
+	empty := New(testHook, testKey)
+	if empty.Find("a") != nil || empty.LongestPrefix("a") != nil || empty.Front() != nil || empty.Back() != nil {
+		t.Errorf("empty tree has elements")
+	}
+}
+
+func TestTreeLongestPrefix(t *testing.T) {
+	tree, _ := newTestTree("10.", "10.0.", "10.0.0.", "10.1.", "192.168.", "192.168.1.", "")
+	tests := map[string]struct {
+		key  string
+		want string
+	}{
+		"exact":          {"10.0.", "10.0."},
+		"longest":        {"10.0.0.15", "10.0.0."},
+		"middle":         {"10.0.1.15", "10.0."},
+		"short":          {"10.2.0.1", "10."},
+		"sibling branch": {"192.168.1.7", "192.168.1."},
+		"other branch":   {"192.168.2.7", "192.168."},
+		"default":        {"172.16.0.1", ""},
+		"empty":          {"", ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tree.LongestPrefix(tt.key); got == nil || got.key != tt.want {
+				t.Errorf("LongestPrefix(%q) = %v, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+	tree.Erase(tree.Find(""))
+	if got := tree.LongestPrefix("172.16.0.1"); got != nil {
+		t.Errorf("LongestPrefix without default = %q", got.key)
+	}
+}
+
+func TestTreeWithPrefix(t *testing.T) {
+	tree, _ := newTestTree("car", "cart", "carbon", "cat", "dog", "", "c")
+	tests := map[string]struct {
+		prefix string
+		stop   int
+		want   []string
+	}{
+		"all":      {"", 0, []string{"", "c", "car", "carbon", "cart", "cat", "dog"}},
+		"subtree":  {"car", 0, []string{"car", "carbon", "cart"}},
+		"partial":  {"ca", 0, []string{"car", "carbon", "cart", "cat"}},
+		"single":   {"do", 0, []string{"dog"}},
+		"exact":    {"cat", 0, []string{"cat"}},
+		"absent":   {"cab", 0, nil},
+		"longer":   {"carts", 0, nil},
+		"stopped":  {"c", 2, []string{"c", "car"}},
+		"past end": {"e", 0, nil},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for e := range tree.WithPrefix(tt.prefix) {
+				got = append(got, e.key)
+				if len(got) == tt.stop {
+					break
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("WithPrefix(%q) = %q, want %q", tt.prefix, got, tt.want)
+			}
+		})
+	}
+	empty := New(testHook, testKey)
+	if got := seqKeys(empty.WithPrefix("")); got != nil {
+		t.Errorf("empty tree iterated %q", got)
+	}
+}
+
+func TestTreeWithPrefixErase(t *testing.T) {
+	tree, _ := newTestTree("a", "ab", "abc", "abd", "b", "ba")
+	for e := range tree.WithPrefix("ab") {
+		if !tree.Erase(e) {
+			t.Fatalf("element %q not erased", e.key)
+		}
+	}
+	if got := treeKeys(&tree); !slices.Equal(got, []string{"a", "b", "ba"}) {
+		t.Errorf("tree has keys %q after erase", got)
+	}
+}
+
+func TestTreeErase(t *testing.T) {
+	keys := []string{"m", "c", "x", "a", "e", "ab", "abc", "xy", "", "mn", "e\x00"}
+	for i := range keys {
+		t.Run(keys[i], func(t *testing.T) {
+			tree, items := newTestTree(keys...)
+			if !tree.Erase(&items[i]) || tree.Erase(&items[i]) {
+				t.Fatalf("erase of %q returned wrong result", keys[i])
+			}
+			if items[i].hook != NewHook[testItem]() {
+				t.Errorf("hook of erased element is not reset")
+			}
+			want := slices.Sorted(slices.Values(slices.Delete(slices.Clone(keys), i, i+1)))
+			if got := treeKeys(&tree); !slices.Equal(got, want) {
+				t.Errorf("tree has keys %q, want %q", got, want)
+			}
+			for j := range items {
+				if j != i && tree.Find(items[j].key) != &items[j] {
+					t.Errorf("element %q not found", items[j].key)
+				}
+			}
+		})
+	}
+	tree, _ := newTestTree("a")
+	foreign := testItem{key: "a"}
+	if tree.Erase(&foreign) || tree.Erase(nil) {
+		t.Errorf("foreign element erased")
+	}
+}
+
+func TestTreeOrder(t *testing.T) {
+	tree, _ := newTestTree("delta", "alpha", "charlie", "bravo", "echo", "al")
+	want := []string{"al", "alpha", "bravo", "charlie", "delta", "echo"}
+	var forward, backward []string
+	for e := tree.Front(); e != nil; e = tree.Next(e) {
+		forward = append(forward, e.key)
+	}
+	for e := tree.Back(); e != nil; e = tree.Prev(e) {
+		backward = append(backward, e.key)
+	}
+	slices.Reverse(backward)
+	if !slices.Equal(forward, want) || !slices.Equal(backward, want) {
+		t.Errorf("forward %q and backward %q order, want %q", forward, backward, want)
+	}
+}
+
+func TestTreeClear(t *testing.T) {
+	tree, items := newTestTree("b", "a", "c")
+	removed := tree.Clear()
+	if len(removed) != 3 || removed[0].key != "a" || removed[2].key != "c" || !tree.Empty() || tree.Front() != nil {
+		t.Errorf("clear returned %d elements, tree has %d", len(removed), tree.Len())
+	}
+	for i := range items {
+		if items[i].hook != NewHook[testItem]() {
+			t.Errorf("hook of %q is not reset", items[i].key)
+		}
+	}
+}
+
+type byteItem struct {
+	hook Hook[byteItem]
+	key  []byte
+}
+
+func TestTreeByteKeys(t *testing.T) {
+	tree := New(func(e *byteItem) *Hook[byteItem] { return &e.hook }, func(e *byteItem) []byte { return e.key })
+	items := []byteItem{{key: []byte{10, 0}}, {key: []byte{10}}, {key: []byte{192, 168}}}
+	for i := range items {
+		tree.Insert(&items[i])
+	}
+	if tree.Find([]byte{10}) != &items[1] || tree.LongestPrefix([]byte{10, 0, 0, 1}) != &items[0] || tree.Front() != &items[1] {
+		t.Errorf("byte keys are not found")
+	}
+}
@@ -0,0 +1,53 @@
+//go:build debug
+
+package radix
+
+import (
+	"fmt"
+)
+
+func (t *Tree[K, T]) verifyElementNotLinked(element *T) {
+	hook := t.hookFunc(element)
+	if hook.parent != nil || hook.branch.used || element == t.root {
+		panic(fmt.Sprintf("already linked element detected: Tree %p element: %p", t, element))
+	}
+}
+
+// Check subtree and return number of its leaves
+func (t *Tree[K, T]) verifySubtree(node *T, leaf bool, parent *T, index int, mask uint16) int {
+	if node == nil {
+		panic(fmt.Sprintf("nil child detected: Tree %p branch: %p", t, parent))
+	}
+	if leaf {
+		if t.hookFunc(node).parent != parent {
+			panic(fmt.Sprintf("parent mismatch: Tree %p element: %p", t, node))
+		}
+		return 1
+	}
+	b := t.branch(node)
+	if !b.used || b.parent != parent {
+		panic(fmt.Sprintf("broken branch detected: Tree %p branch: %p", t, node))
+	}
+	if b.index < index || b.index == index && b.mask >= mask {
+		panic(fmt.Sprintf("critical bit order violated: Tree %p branch: %p", t, node))
+	}
+	count := 0
+	for side := range 2 {
+		count += t.verifySubtree(b.child[side], b.leaf[side], node, b.index, b.mask)
+		first, last := t.first(b.child[side], b.leaf[side]), t.last(b.child[side], b.leaf[side])
+		if direction(t.keyFunc(first), b.index, b.mask) != side || direction(t.keyFunc(last), b.index, b.mask) != side {
+			panic(fmt.Sprintf("element on wrong side detected: Tree %p branch: %p", t, node))
+		}
+	}
+	return count
+}
+
+func (t *Tree[K, T]) verify() {
+	count := 0
+	if t.root != nil {
+		count = t.verifySubtree(t.root, t.rootLeaf, nil, -1, 0)
+	}
+	if count != t.size {
+		panic(fmt.Sprintf("size mismatch: expected %d, got %d: Tree %p", t.size, count, t))
+	}
+}
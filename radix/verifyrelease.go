@@ -0,0 +1,9 @@
+//go:build !debug
+
+package radix
+
+func (t *Tree[K, T]) verifyElementNotLinked(element *T) {
+}
+
+func (t *Tree[K, T]) verify() {
+}
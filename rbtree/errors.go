@@ -0,0 +1,47 @@
+package rbtree
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrAlreadyLinked is the error safe-mode RbTree panics with when already linked element is inserted
+	ErrAlreadyLinked = errors.New("rbtree: element is already linked")
+	// ErrNotMember is the error safe-mode RbTree panics with when element of other container is erased
+	ErrNotMember = errors.New("rbtree: element is not a member of the tree")
+	// ErrSizeMismatch is returned by Validate when number of linked nodes is not equal to the size of RbTree
+	ErrSizeMismatch = errors.New("rbtree: size mismatch")
+	// ErrParentPointer is returned by Validate when parent pointer of a node does not point to its parent
+	ErrParentPointer = errors.New("rbtree: parent pointer mismatch")
+	// ErrBlackHeight is returned by Validate when paths from a node to its leaves have different number of black nodes
+	ErrBlackHeight = errors.New("rbtree: black height mismatch")
+	// ErrRedViolation is returned by Validate when red node has red child or root is red
+	ErrRedViolation = errors.New("rbtree: red node violation")
+	// ErrOrder is returned by Validate when nodes are not ordered according to lessFunc
+	ErrOrder = errors.New("rbtree: order violation")
+	// ErrBrokenLink is returned by Validate when first or last node of RbTree is not the leftmost or rightmost one
+	ErrBrokenLink = errors.New("rbtree: broken link")
+)
+
+type (
+	// ValidationError is returned by Validate and holds the offending node.
+	// Element may be nil if error is not related to a particular node
+	ValidationError[T any] struct {
+		Err     error
+		Element *T
+	}
+)
+
+// Error returns error description with address of the offending node
+func (e *ValidationError[T]) Error() string {
+	if e.Element == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v: element: %p", e.Err, e.Element)
+}
+
+// Unwrap returns underlying sentinel error
+func (e *ValidationError[T]) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,112 @@
+package rbtree
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"unsafe"
+)
+
+// Hook is intentionally not the first field to test non-zero offset
+type testOffsetItem struct {
+	value int
+	hook  Hook[testOffsetItem]
+}
+
+var testOffset = unsafe.Offsetof(testOffsetItem{}.hook)
+
+func offsetHook(self *testOffsetItem) *Hook[testOffsetItem] {
+	return &self.hook
+}
+
+func lessOffset(lhs, rhs *testOffsetItem) bool {
+	return lhs.value < rhs.value
+}
+
+func TestOffsetRbTreeInsertFindErase(t *testing.T) {
+	tree := NewOffset(testOffset, lessOffset)
+	items := make([]testOffsetItem, 100)
+	for i, v := range rand.Perm(len(items)) {
+		items[i].value = v
+		if !tree.Insert(&items[i]) {
+			t.Fatalf("offset tree rejected unique element %v", v)
+		}
+	}
+	if err := tree.Validate(); err != nil {
+		t.Fatalf("offset tree is invalid: %v", err)
+	}
+	if f := tree.Find(&testOffsetItem{value: 42}); f == nil || f.value != 42 {
+		t.Errorf("offset tree find returned %v", f)
+	}
+	for i := range items {
+		if items[i].value%2 == 0 {
+			tree.Erase(&items[i])
+		}
+	}
+	if err := tree.Validate(); err != nil || tree.Len() != 50 {
+		t.Errorf("offset tree after erase has size %v and error %v", tree.Len(), err)
+	}
+	if tree.Front().value != 1 || tree.Back().value != 99 {
+		t.Errorf("offset tree has front %v and back %v", tree.Front().value, tree.Back().value)
+	}
+}
+
+func TestOffsetRbTreeSwapWithHookFuncTree(t *testing.T) {
+	o := NewOffset(testOffset, lessOffset)
+	h := NewRbTree(offsetHook, lessOffset)
+	o.Insert(&testOffsetItem{value: 1})
+	h.Insert(&testOffsetItem{value: 2})
+	o.Swap(h)
+	o.Insert(&testOffsetItem{value: 3})
+	h.Insert(&testOffsetItem{value: 0})
+	if o.Validate() != nil || h.Validate() != nil || o.Front().value != 2 || h.Front().value != 0 {
+		t.Errorf("trees after swap are unexpected")
+	}
+}
+
+// Zero value RbTree has neither hookFunc nor offset and MUST NOT access memory at offset 0
+func TestOffsetZeroValueRbTreePanics(t *testing.T) {
+	var l RbTree[testOffsetItem]
+	item := testOffsetItem{value: 1}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("zero value RbTree accepted element")
+		}
+		if item.value != 1 || item.hook != (Hook[testOffsetItem]{}) {
+			t.Errorf("zero value RbTree modified memory of element")
+		}
+	}()
+	l.Insert(&item)
+}
+
+func benchmarkRbTreeInsert(b *testing.B, size int, newTree func() *RbTree[testOffsetItem]) {
+	items := make([]testOffsetItem, size)
+	values := rand.Perm(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		tree := newTree()
+		for j := range items {
+			items[j] = testOffsetItem{value: values[j]}
+		}
+		b.StartTimer()
+		for j := range items {
+			tree.Insert(&items[j])
+		}
+	}
+}
+
+func BenchmarkRbTreeInsert(b *testing.B) {
+	for _, size := range []int{100, 10000} {
+		b.Run(fmt.Sprintf("hookFunc/%d", size), func(b *testing.B) {
+			benchmarkRbTreeInsert(b, size, func() *RbTree[testOffsetItem] { return NewRbTree(offsetHook, lessOffset) })
+		})
+		b.Run(fmt.Sprintf("offset/%d", size), func(b *testing.B) {
+			benchmarkRbTreeInsert(b, size, func() *RbTree[testOffsetItem] {
+				tree := NewOffset(testOffset, lessOffset)
+				return &tree
+			})
+		})
+	}
+}
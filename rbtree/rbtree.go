@@ -1,5 +1,9 @@
 package rbtree
 
+import (
+	"unsafe"
+)
+
 type color int
 
 const (
@@ -20,6 +24,9 @@ type (
 	// it is inside tree
 	RbTree[T any] struct {
 		hookFunc          func(*T) *Hook[T]
+		offset            uintptr
+		useOffset         bool
+		ownerFunc         func(*T) **RbTree[T]
 		lessFunc          func(*T, *T) bool
 		size              int
 		first, root, last *T
@@ -41,7 +48,35 @@ func NewHook[T any]() Hook[T] {
 	return Hook[T]{left: nil, parent: nil, right: nil, color: black}
 }
 
-// NewRbTree creates a new Red-Black Tree
+// New creates a new Red-Black Tree.
+//
+// It returns the tree by value, same as constructors of list containers
+func New[T any](hookFunc func(*T) *Hook[T], lessFunc func(*T, *T) bool) RbTree[T] {
+	return RbTree[T]{
+		hookFunc: hookFunc,
+		lessFunc: lessFunc,
+	}
+}
+
+// NewOffset creates a new Red-Black Tree of elements which Hook is located at offset
+// from the start of element.
+//
+// Offset should be computed with unsafe.Offsetof, e.g. unsafe.Offsetof(item.hook).
+// Hook is accessed with pointer arithmetic instead of a call of hookFunc
+// on every step of every operation.
+//
+// Same as New it returns the tree by value
+func NewOffset[T any](offset uintptr, lessFunc func(*T, *T) bool) RbTree[T] {
+	return RbTree[T]{
+		offset:    offset,
+		useOffset: true,
+		lessFunc:  lessFunc,
+	}
+}
+
+// NewRbTree creates a new Red-Black Tree allocated on heap.
+//
+// Deprecated: use New, it returns the tree by value same as constructors of other containers
 func NewRbTree[T any](hookFunc func(*T) *Hook[T], lessFunc func(*T, *T) bool) *RbTree[T] {
 	return &RbTree[T]{
 		hookFunc: hookFunc,
@@ -50,7 +85,7 @@ func NewRbTree[T any](hookFunc func(*T) *Hook[T], lessFunc func(*T, *T) bool) *R
 }
 
 // Next returns the next node in in-order traversal
-func (t RbTree[T]) Next(node *T) *T {
+func (t *RbTree[T]) Next(node *T) *T {
 	if node == nil {
 		return nil
 	}
@@ -59,7 +94,7 @@ func (t RbTree[T]) Next(node *T) *T {
 }
 
 // Prev returns the previous node in in-order traversal
-func (t RbTree[T]) Prev(node *T) *T {
+func (t *RbTree[T]) Prev(node *T) *T {
 	if node == nil {
 		return nil
 	}
@@ -67,6 +102,34 @@ func (t RbTree[T]) Prev(node *T) *T {
 	return t.prev(node)
 }
 
+// Root returns root node of the tree or nil if tree is empty.
+//
+// Root, Left, Right, Parent and IsRed expose tree structure for diagnostics,
+// they do not verify membership of node even in builds with debug tag
+func (t *RbTree[T]) Root() *T {
+	return t.root
+}
+
+// Left returns left child of node
+func (t *RbTree[T]) Left(node *T) *T {
+	return t.left(node)
+}
+
+// Right returns right child of node
+func (t *RbTree[T]) Right(node *T) *T {
+	return t.right(node)
+}
+
+// Parent returns parent of node or nil if node is the root
+func (t *RbTree[T]) Parent(node *T) *T {
+	return t.parent(node)
+}
+
+// IsRed checks if node is colored red
+func (t *RbTree[T]) IsRed(node *T) bool {
+	return t.color(node) == red
+}
+
 // Init initializes the tree to empty state
 func (t *RbTree[T]) Init() {
 	t.root = nil
@@ -75,63 +138,66 @@ func (t *RbTree[T]) Init() {
 	t.size = 0
 }
 
-func (t RbTree[T]) getHook(node *T) *Hook[T] {
+func (t *RbTree[T]) getHook(node *T) *Hook[T] {
 	if node == nil {
 		return nil
 	}
+	if t.useOffset {
+		return (*Hook[T])(unsafe.Add(unsafe.Pointer(node), t.offset))
+	}
 	return t.hookFunc(node)
 }
 
-func (t RbTree[T]) left(node *T) *T {
+func (t *RbTree[T]) left(node *T) *T {
 	return t.getHook(node).left
 }
 
-func (t RbTree[T]) setLeft(node *T, left *T) {
+func (t *RbTree[T]) setLeft(node *T, left *T) {
 	t.getHook(node).left = left
 }
 
-func (t RbTree[T]) right(node *T) *T {
+func (t *RbTree[T]) right(node *T) *T {
 	return t.getHook(node).right
 }
 
-func (t RbTree[T]) setRight(node *T, right *T) {
+func (t *RbTree[T]) setRight(node *T, right *T) {
 	t.getHook(node).right = right
 }
 
-func (t RbTree[T]) parent(node *T) *T {
+func (t *RbTree[T]) parent(node *T) *T {
 	return t.getHook(node).parent
 }
 
-func (t RbTree[T]) setParent(node *T, parent *T) {
+func (t *RbTree[T]) setParent(node *T, parent *T) {
 	t.getHook(node).parent = parent
 }
 
-func (t RbTree[T]) color(node *T) color {
+func (t *RbTree[T]) color(node *T) color {
 	if node == nil {
 		return black
 	}
 	return t.getHook(node).color
 }
 
-func (t RbTree[T]) setColor(node *T, color color) {
+func (t *RbTree[T]) setColor(node *T, color color) {
 	t.getHook(node).color = color
 }
 
-func (t RbTree[T]) min(node *T) *T {
+func (t *RbTree[T]) min(node *T) *T {
 	for t.left(node) != nil {
 		node = t.left(node)
 	}
 	return node
 }
 
-func (t RbTree[T]) max(node *T) *T {
+func (t *RbTree[T]) max(node *T) *T {
 	for t.right(node) != nil {
 		node = t.right(node)
 	}
 	return node
 }
 
-func (t RbTree[T]) next(node *T) *T {
+func (t *RbTree[T]) next(node *T) *T {
 	if t.right(node) != nil {
 		return t.min(t.right(node))
 	}
@@ -143,7 +209,7 @@ func (t RbTree[T]) next(node *T) *T {
 	return parent
 }
 
-func (t RbTree[T]) prev(node *T) *T {
+func (t *RbTree[T]) prev(node *T) *T {
 	if t.left(node) != nil {
 		return t.max(t.left(node))
 	}
@@ -305,17 +371,17 @@ func (t *RbTree[T]) transplant(u, v *T) {
 }
 
 // Empty returns true if tree is empty
-func (t RbTree[T]) Empty() bool {
+func (t *RbTree[T]) Empty() bool {
 	return t.size == 0
 }
 
 // Size returns the number of elements in the tree
-func (t RbTree[T]) Size() int {
+func (t *RbTree[T]) Size() int {
 	return t.size
 }
 
 // Len returns the number of elements in the tree
-func (t RbTree[T]) Len() int {
+func (t *RbTree[T]) Len() int {
 	return t.size
 }
 
@@ -325,20 +391,25 @@ func (t *RbTree[T]) Swap(other *RbTree[T]) {
 		return
 	}
 	other.hookFunc, t.hookFunc = t.hookFunc, other.hookFunc
+	other.offset, t.offset = t.offset, other.offset
+	other.useOffset, t.useOffset = t.useOffset, other.useOffset
+	other.ownerFunc, t.ownerFunc = t.ownerFunc, other.ownerFunc
 	other.lessFunc, t.lessFunc = t.lessFunc, other.lessFunc
 	t.root, other.root = other.root, t.root
 	t.first, other.first = other.first, t.first
 	t.last, other.last = other.last, t.last
 	t.size, other.size = other.size, t.size
+	t.setOwner(t)
+	other.setOwner(other)
 }
 
 // Front returns the first (leftmost) node in the tree
-func (t RbTree[T]) Front() *T {
+func (t *RbTree[T]) Front() *T {
 	return t.first
 }
 
 // Back returns the last (rightmost) node in the tree
-func (t RbTree[T]) Back() *T {
+func (t *RbTree[T]) Back() *T {
 	return t.last
 }
 
@@ -346,6 +417,7 @@ func (t RbTree[T]) Back() *T {
 func (t *RbTree[T]) Clear() []*T {
 	nodes := make([]*T, 0, t.size)
 
+	t.setOwner(nil)
 	t.TraversePostOrder(func(node *T) {
 		nodes = append(nodes, node)
 		t.getHook(node).Init()
@@ -356,7 +428,7 @@ func (t *RbTree[T]) Clear() []*T {
 }
 
 // Traverse traverses tree in-order
-func (t RbTree[T]) Traverse(f func(*T)) {
+func (t *RbTree[T]) Traverse(f func(*T)) {
 	var traverse func(*T)
 	traverse = func(node *T) {
 		if node == nil {
@@ -371,7 +443,7 @@ func (t RbTree[T]) Traverse(f func(*T)) {
 }
 
 // TraversePreOrder traverses tree in pre-order
-func (t RbTree[T]) TraversePreOrder(f func(*T)) {
+func (t *RbTree[T]) TraversePreOrder(f func(*T)) {
 	var traverse func(*T)
 	traverse = func(node *T) {
 		if node == nil {
@@ -386,7 +458,7 @@ func (t RbTree[T]) TraversePreOrder(f func(*T)) {
 }
 
 // TraversePostOrder traverses tree in post-order
-func (t RbTree[T]) TraversePostOrder(f func(*T)) {
+func (t *RbTree[T]) TraversePostOrder(f func(*T)) {
 	var traverse func(*T)
 	traverse = func(node *T) {
 		if node == nil {
@@ -405,6 +477,7 @@ func (t *RbTree[T]) Insert(item *T) bool {
 	if item == nil {
 		return false
 	}
+	t.checkNotLinked(item)
 	t.verifyElementNotLinked(item)
 	defer t.verify()
 
@@ -441,6 +514,9 @@ func (t *RbTree[T]) Insert(item *T) bool {
 	t.setColor(item, red)
 	t.insertFixup(item)
 	t.size++
+	if t.ownerFunc != nil {
+		*t.ownerFunc(item) = t
+	}
 	return true
 }
 
@@ -449,6 +525,7 @@ func (t *RbTree[T]) Erase(item *T) bool {
 	if item == nil {
 		return false
 	}
+	t.checkIsMember(item)
 	t.verifyNotEmpty()
 	t.verifyIsMemberOfCurrent(item)
 	defer t.verifyElementNotLinked(item)
@@ -495,10 +572,11 @@ func (t *RbTree[T]) Erase(item *T) bool {
 	if item == t.last {
 		t.last = t.prev(item)
 	}
-	t.setLeft(item, nil)
-	t.setRight(item, nil)
-	t.setParent(item, nil)
+	t.getHook(item).Init()
 	t.size--
+	if t.ownerFunc != nil {
+		*t.ownerFunc(item) = nil
+	}
 	return true
 }
 
@@ -523,12 +601,12 @@ func (t *RbTree[T]) Merge(other *RbTree[T]) {
 }
 
 // Contains checks if element that compares equal with item exists in tree
-func (t RbTree[T]) Contains(item *T) bool {
+func (t *RbTree[T]) Contains(item *T) bool {
 	return t.Find(item) != nil
 }
 
 // Find searches for an element that compares equal with item
-func (t RbTree[T]) Find(item *T) *T {
+func (t *RbTree[T]) Find(item *T) *T {
 	if item == nil {
 		return nil
 	}
@@ -546,7 +624,7 @@ func (t RbTree[T]) Find(item *T) *T {
 }
 
 // LowerBound finds first element not less than item
-func (t RbTree[T]) LowerBound(item *T) *T {
+func (t *RbTree[T]) LowerBound(item *T) *T {
 	if item == nil {
 		return nil
 	}
@@ -564,7 +642,7 @@ func (t RbTree[T]) LowerBound(item *T) *T {
 }
 
 // UpperBound finds first element greater than item
-func (t RbTree[T]) UpperBound(item *T) *T {
+func (t *RbTree[T]) UpperBound(item *T) *T {
 	if item == nil {
 		return nil
 	}
@@ -611,7 +689,7 @@ func (t *RbTree[T]) EraseIf(predicate func(*T) bool) (erased []*T) {
 }
 
 // Includes checks if tree contains all elements of another tree
-func (t RbTree[T]) Includes(other *RbTree[T]) bool {
+func (t *RbTree[T]) Includes(other *RbTree[T]) bool {
 	if other == nil {
 		return false
 	}
@@ -634,7 +712,7 @@ func (t RbTree[T]) Includes(other *RbTree[T]) bool {
 }
 
 // Difference returns elements in tree but not in other
-func (t RbTree[T]) Difference(other *RbTree[T]) []*T {
+func (t *RbTree[T]) Difference(other *RbTree[T]) []*T {
 	var result []*T
 	if other == nil {
 		return result
@@ -660,7 +738,7 @@ func (t RbTree[T]) Difference(other *RbTree[T]) []*T {
 }
 
 // Intersection returns elements common to both trees
-func (t RbTree[T]) Intersection(other *RbTree[T]) []*T {
+func (t *RbTree[T]) Intersection(other *RbTree[T]) []*T {
 	var result []*T
 	if other == nil {
 		return result
@@ -682,7 +760,7 @@ func (t RbTree[T]) Intersection(other *RbTree[T]) []*T {
 }
 
 // SymDifference returns elements not common to both trees
-func (t RbTree[T]) SymDifference(other *RbTree[T]) []*T {
+func (t *RbTree[T]) SymDifference(other *RbTree[T]) []*T {
 	var result []*T
 	if other == nil {
 		return result
@@ -713,7 +791,7 @@ func (t RbTree[T]) SymDifference(other *RbTree[T]) []*T {
 }
 
 // Union returns all elements from both trees
-func (t RbTree[T]) Union(other *RbTree[T]) []*T {
+func (t *RbTree[T]) Union(other *RbTree[T]) []*T {
 	var result []*T
 	if other == nil {
 		return result
@@ -2,7 +2,10 @@ package rbtree
 
 import (
 	"encoding/binary"
+	"slices"
 	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/oracle"
 )
 
 type fuzzEmbedItem struct {
@@ -53,7 +56,14 @@ const (
 	opCOUNT
 )
 
-func verifyTreeConsistency(t *testing.T, tree *RbTree[fuzzEmbedItem], treeIdx int) {
+func verifyTreeConsistency(t *testing.T, tree *RbTree[fuzzEmbedItem], treeIdx int, model *oracle.Set[*fuzzEmbedItem]) {
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Validate failed: %v", err)
+	}
+	if diff := oracle.Diff(oracle.Collect(tree.Front(), tree.Next), model.Elements()); diff != "" {
+		t.Errorf("Tree differs from model: %s", diff)
+	}
+
 	if tree.Empty() {
 		if tree.Front() != nil || tree.Back() != nil || tree.Size() != 0 {
 			t.Errorf("Empty tree inconsistency: front=%v, back=%v, size=%d", tree.Front(), tree.Back(), tree.Size())
@@ -110,116 +120,18 @@ func verifyTreeConsistency(t *testing.T, tree *RbTree[fuzzEmbedItem], treeIdx in
 	})
 }
 
-func referenceIncludes[T any](t1, t2 *RbTree[T]) bool {
-	if t2 == nil {
-		return false
-	}
-	for node := t2.Front(); node != nil; node = t2.Next(node) {
-		if t1.Find(node) == nil {
-			return false
-		}
-	}
-	return true
-}
-
-func referenceDifference[T any](t1, t2 *RbTree[T]) []*T {
-	var result []*T
-	for node := t1.Front(); node != nil && t2 != nil; node = t1.Next(node) {
-		if t2.Find(node) == nil {
-			result = append(result, node)
-		}
-	}
-	return result
-}
-
-func referenceIntersection[T any](t1, t2 *RbTree[T]) []*T {
-	var result []*T
-	for node := t1.Front(); node != nil && t2 != nil; node = t1.Next(node) {
-		if t2.Find(node) != nil {
-			result = append(result, node)
-		}
-	}
-	return result
-}
-
-func referenceSymDifference[T any](t1, t2 *RbTree[T]) []*T {
-	if t2 == nil {
-		return nil
-	}
-	diff1 := referenceDifference(t1, t2)
-	diff2 := referenceDifference(t2, t1)
-	return append(diff1, diff2...)
-}
-
-func referenceUnion[T any](t1, t2 *RbTree[T]) []*T {
-	var result []*T
-	if t2 == nil {
-		return result
-	}
-	for node := t1.Front(); node != nil; node = t1.Next(node) {
-		result = append(result, node)
-	}
-	diff := referenceDifference(t2, t1)
-	return append(result, diff...)
-}
-
-func referenceFind[T any](tree *RbTree[T], item *T) *T {
-	for node := tree.Front(); node != nil && item != nil; node = tree.Next(node) {
-		if !tree.lessFunc(node, item) && !tree.lessFunc(item, node) {
-			return node
-		}
-	}
-	return nil
-}
-
-func referenceLowerBound[T any](tree *RbTree[T], item *T) *T {
-	var candidate *T
-	for node := tree.Front(); node != nil && item != nil; node = tree.Next(node) {
-		if !tree.lessFunc(node, item) && (candidate == nil || tree.lessFunc(node, candidate)) {
-			candidate = node
-		}
-	}
-	return candidate
-}
-
-func referenceUpperBound[T any](tree *RbTree[T], item *T) *T {
-	var candidate *T
-	for node := tree.Front(); node != nil && item != nil; node = tree.Next(node) {
-		if tree.lessFunc(item, node) && (candidate == nil || tree.lessFunc(node, candidate)) {
-			candidate = node
-		}
-	}
-	return candidate
-}
-
-func compareSlices[T any](a, b []*T) (bool, int) {
-	if len(a) != len(b) {
-		return false, -1
-	}
-	check := make(map[*T]bool, len(a))
-	for _, e := range a {
-		check[e] = true
-	}
-	for i := range b {
-		if !check[b[i]] {
-			return false, i
-		}
-	}
-	return true, -1
-}
-
-func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedItem]) func(op, arg1, arg2, arg3 byte, arg4 uint32) {
+func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedItem], models []oracle.Set[*fuzzEmbedItem]) func(op, arg1, arg2, arg3 byte, arg4 uint32) {
 	return func(op, arg1, arg2, arg3 byte, arg4 uint32) {
 		treeIdx := int(arg1) % len(trees)
 		itemIdx := int(arg4) % len(items)
 		tree2Idx := int(arg3) % len(trees)
 
-		tree := trees[treeIdx]
+		tree, m := trees[treeIdx], &models[treeIdx]
 		item := &items[itemIdx]
-		tree2 := trees[tree2Idx]
+		tree2, m2 := trees[tree2Idx], &models[tree2Idx]
 		if 196 < arg2 {
 			item = nil
-			tree2 = nil
+			tree2, m2 = nil, nil
 
 			// Special cases
 			switch op {
@@ -237,17 +149,20 @@ func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedIte
 		switch op % opCOUNT {
 		case opInsert:
 			if item == nil || !item.isUsed {
-				if tree.Insert(item) {
+				inserted := tree.Insert(item)
+				if item != nil && inserted != m.Insert(item) {
+					t.Errorf("Insert of %v returned %v while model disagrees", item, inserted)
+				}
+				if inserted {
 					item.isUsed = true
 					item.treeIndex = treeIdx
-				} else if item != nil && !tree.Contains(item) {
-					t.Errorf("Failed to insert item %v", item)
 				}
 			}
 
 		case opErase:
 			if item == nil || item.isUsed && item.treeIndex == treeIdx {
 				if tree.Erase(item) {
+					m.Erase(item)
 					item.isUsed = false
 					item.treeIndex = 0
 				} else if item != nil {
@@ -256,28 +171,40 @@ func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedIte
 			}
 
 		case opClear:
+			// Clear returns elements in post-order
 			cleared := tree.Clear()
 			for _, it := range cleared {
 				it.isUsed = false
 				it.treeIndex = 0
 			}
+			slices.SortFunc(cleared, func(lhs, rhs *fuzzEmbedItem) int { return lhs.value - rhs.value })
+			verifyResult(t, "Clear", cleared, m.Clear())
 
 		case opFind:
-			expected := referenceFind(tree, item)
+			var expected *fuzzEmbedItem
+			if item != nil {
+				expected = m.Find(item)
+			}
 			actual := tree.Find(item)
 			if expected != actual {
 				t.Errorf("Find mismatch: expected %v, got %v", expected, actual)
 			}
 
 		case opLowerBound:
-			expected := referenceLowerBound(tree, item)
+			var expected *fuzzEmbedItem
+			if item != nil {
+				expected = m.LowerBound(item)
+			}
 			actual := tree.LowerBound(item)
 			if expected != actual {
 				t.Errorf("LowerBound mismatch: expected %v, got %v", expected, actual)
 			}
 
 		case opUpperBound:
-			expected := referenceUpperBound(tree, item)
+			var expected *fuzzEmbedItem
+			if item != nil {
+				expected = m.UpperBound(item)
+			}
 			actual := tree.UpperBound(item)
 			if expected != actual {
 				t.Errorf("UpperBound mismatch: expected %v, got %v", expected, actual)
@@ -290,6 +217,9 @@ func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedIte
 					originalSizes += tree2.Size()
 				}
 				tree.Merge(tree2)
+				if tree2 != nil {
+					m.Merge(m2)
+				}
 				afterMergeSizes := tree.Size()
 				if tree2 != nil {
 					afterMergeSizes += tree2.Size()
@@ -304,7 +234,7 @@ func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedIte
 
 		case opIncludes:
 			if tree != tree2 {
-				expected := referenceIncludes(tree, tree2)
+				expected := tree2 != nil && m.Includes(m2)
 				actual := tree.Includes(tree2)
 				if expected != actual {
 					t.Errorf("Includes mismatch: expected %v, got %v", expected, actual)
@@ -313,54 +243,38 @@ func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedIte
 
 		case opDifference:
 			if tree != tree2 {
-				expected := referenceDifference(tree, tree2)
-				actual := tree.Difference(tree2)
-				if e, i := compareSlices(expected, actual); !e {
-					if i != -1 {
-						t.Errorf("Difference mismatch at %v a: %v", i, actual[i])
-					} else {
-						t.Errorf("Difference mismatch in length len(e): %v len(a): %v", len(expected), len(actual))
-					}
+				var expected []*fuzzEmbedItem
+				if tree2 != nil {
+					expected = m.Difference(m2)
 				}
+				verifyResult(t, "Difference", tree.Difference(tree2), expected)
 			}
 
 		case opIntersection:
 			if tree != tree2 {
-				expected := referenceIntersection(tree, tree2)
-				actual := tree.Intersection(tree2)
-				if e, i := compareSlices(expected, actual); !e {
-					if i != -1 {
-						t.Errorf("Intersection mismatch at %v a: %v", i, actual[i])
-					} else {
-						t.Errorf("Intersection mismatch in length len(e): %v len(a): %v", len(expected), len(actual))
-					}
+				var expected []*fuzzEmbedItem
+				if tree2 != nil {
+					expected = m.Intersection(m2)
 				}
+				verifyResult(t, "Intersection", tree.Intersection(tree2), expected)
 			}
 
 		case opSymDifference:
 			if tree != tree2 {
-				expected := referenceSymDifference(tree, tree2)
-				actual := tree.SymDifference(tree2)
-				if e, i := compareSlices(expected, actual); !e {
-					if i != -1 {
-						t.Errorf("SymDifference mismatch at %v a: %v", i, actual[i])
-					} else {
-						t.Errorf("SymDifference mismatch in length len(e): %v len(a): %v", len(expected), len(actual))
-					}
+				var expected []*fuzzEmbedItem
+				if tree2 != nil {
+					expected = m.SymDifference(m2)
 				}
+				verifyResult(t, "SymDifference", tree.SymDifference(tree2), expected)
 			}
 
 		case opUnion:
 			if tree != tree2 {
-				expected := referenceUnion(tree, tree2)
-				actual := tree.Union(tree2)
-				if e, i := compareSlices(expected, actual); !e {
-					if i != -1 {
-						t.Errorf("Union mismatch at %v a: %v", i, actual[i])
-					} else {
-						t.Errorf("Union mismatch in length len(e): %v len(a): %v", len(expected), len(actual))
-					}
+				var expected []*fuzzEmbedItem
+				if tree2 != nil {
+					expected = m.Union(m2)
 				}
+				verifyResult(t, "Union", tree.Union(tree2), expected)
 			}
 
 		case opEraseIf:
@@ -385,13 +299,23 @@ func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedIte
 			}
 
 			erased := tree.EraseIf(predicate)
+			var expected []*fuzzEmbedItem
+			for _, e := range m.Elements() {
+				if predicate(e) {
+					expected = append(expected, e)
+				}
+			}
+			for _, e := range expected {
+				m.Erase(e)
+			}
 			for _, e := range erased {
 				e.isUsed = false
 				e.treeIndex = 0
 			}
+			verifyResult(t, "EraseIf", erased, expected)
 
 		case opVerifyTree:
-			verifyTreeConsistency(t, tree, treeIdx)
+			verifyTreeConsistency(t, tree, treeIdx, m)
 
 		case opSize:
 			if tree.Size() < 0 {
@@ -460,6 +384,9 @@ func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedIte
 					size2 += tree2.Size()
 				}
 				tree.Swap(tree2)
+				if tree2 != nil {
+					*m, *m2 = *m2, *m
+				}
 				if tree2 == nil && tree.Size() != size1 {
 					t.Errorf("Swap size inconsistency with nil")
 				}
@@ -486,6 +413,7 @@ func nextState(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedIte
 					it.treeIndex = 0
 				}
 			}
+			m.Clear()
 			tree.Init()
 		}
 	}
@@ -506,8 +434,10 @@ func FuzzRbTreeOps(f *testing.F) {
 	}
 
 	f.Fuzz(func(t *testing.T, commands []byte) {
-		// Reset all trees and items
+		// Reset all trees, their models and items
+		models := make([]oracle.Set[*fuzzEmbedItem], numTrees)
 		for i := range trees {
+			models[i] = oracle.NewSet(lessFuzz)
 			if elements := trees[i].Clear(); len(elements) > 0 {
 				for _, e := range elements {
 					e.isUsed = false
@@ -523,7 +453,7 @@ func FuzzRbTreeOps(f *testing.F) {
 			items[i].Hook.Init()
 		}
 
-		next := nextState(t, items, trees)
+		next := nextState(t, items, trees, models)
 
 		for i := 0; i+5 < len(commands); i += 6 {
 			indexArg := binary.LittleEndian.Uint32([]byte{commands[i+4], commands[i+5], 0, 0})
@@ -532,7 +462,7 @@ func FuzzRbTreeOps(f *testing.F) {
 
 		// Verify all trees at the end
 		for i := range trees {
-			verifyTreeConsistency(t, trees[i], i)
+			verifyTreeConsistency(t, trees[i], i, &models[i])
 		}
 
 		// Additional verification: check that all items are either in exactly one tree or not in any tree
@@ -0,0 +1,188 @@
+package rbtree
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/oracle"
+)
+
+const (
+	oracleOpInsert byte = iota
+	oracleOpErase
+	oracleOpClear
+	oracleOpFind
+	oracleOpLowerBound
+	oracleOpUpperBound
+	oracleOpMerge
+	oracleOpIncludes
+	oracleOpDifference
+	oracleOpIntersection
+	oracleOpSymDifference
+	oracleOpUnion
+	oracleOpEraseIf
+	oracleOpSwap
+	oracleOpCOUNT
+)
+
+func fuzzPredicate(kind byte) func(*fuzzEmbedItem) bool {
+	switch kind % 4 {
+	case 0:
+		return func(e *fuzzEmbedItem) bool { return e.value%2 == 0 }
+	case 1:
+		return func(e *fuzzEmbedItem) bool { return e.value%2 == 1 }
+	case 2:
+		return func(e *fuzzEmbedItem) bool { return e.value < 16 }
+	default:
+		return func(e *fuzzEmbedItem) bool { return e.value >= 16 }
+	}
+}
+
+func verifyResult(t *testing.T, op string, got, want []*fuzzEmbedItem) {
+	if diff := oracle.Diff(got, want); diff != "" {
+		t.Fatalf("%s result differs from model: %s", op, diff)
+	}
+}
+
+// Apply command to RbTree and to its reference model
+func oracleStep(t *testing.T, items []fuzzEmbedItem, trees []*RbTree[fuzzEmbedItem], models []oracle.Set[*fuzzEmbedItem]) func(command []byte) {
+	return func(command []byte) {
+		op, arg1, arg2, arg3 := command[0], int(command[1]), int(command[2]), int(command[3])
+		treeIdx, otherIdx := arg1%len(trees), arg2%len(trees)
+		tree, m := trees[treeIdx], &models[treeIdx]
+		other, otherModel := trees[otherIdx], &models[otherIdx]
+		item := &items[arg3%len(items)]
+
+		switch op % oracleOpCOUNT {
+		case oracleOpInsert:
+			if !item.isUsed {
+				inserted := tree.Insert(item)
+				if inserted != m.Insert(item) {
+					t.Fatalf("Insert of %v returned %v while model disagrees", item, inserted)
+				}
+				item.isUsed = inserted
+			}
+
+		case oracleOpErase:
+			if m.Len() != 0 {
+				e := m.Elements()[arg3%m.Len()]
+				m.Erase(e)
+				if !tree.Erase(e) {
+					t.Fatalf("Erase of member %v failed", e)
+				}
+				e.isUsed = false
+			}
+
+		case oracleOpClear:
+			// Clear returns elements in post-order
+			removed := tree.Clear()
+			for _, e := range removed {
+				e.isUsed = false
+			}
+			slices.SortFunc(removed, func(lhs, rhs *fuzzEmbedItem) int { return lhs.value - rhs.value })
+			verifyResult(t, "Clear", removed, m.Clear())
+
+		case oracleOpFind:
+			if got, want := tree.Find(item), m.Find(item); got != want {
+				t.Fatalf("Find of %v returned %v while model has %v", item, got, want)
+			}
+
+		case oracleOpLowerBound:
+			if got, want := tree.LowerBound(item), m.LowerBound(item); got != want {
+				t.Fatalf("LowerBound of %v returned %v while model has %v", item, got, want)
+			}
+
+		case oracleOpUpperBound:
+			if got, want := tree.UpperBound(item), m.UpperBound(item); got != want {
+				t.Fatalf("UpperBound of %v returned %v while model has %v", item, got, want)
+			}
+
+		case oracleOpMerge:
+			tree.Merge(other)
+			m.Merge(otherModel)
+
+		case oracleOpIncludes:
+			if got, want := tree.Includes(other), m.Includes(otherModel); got != want {
+				t.Fatalf("Includes returned %v while model has %v", got, want)
+			}
+
+		case oracleOpDifference:
+			verifyResult(t, "Difference", tree.Difference(other), m.Difference(otherModel))
+
+		case oracleOpIntersection:
+			verifyResult(t, "Intersection", tree.Intersection(other), m.Intersection(otherModel))
+
+		case oracleOpSymDifference:
+			verifyResult(t, "SymDifference", tree.SymDifference(other), m.SymDifference(otherModel))
+
+		case oracleOpUnion:
+			verifyResult(t, "Union", tree.Union(other), m.Union(otherModel))
+
+		case oracleOpEraseIf:
+			predicate := fuzzPredicate(command[3])
+			erased := tree.EraseIf(predicate)
+			want := make([]*fuzzEmbedItem, 0)
+			for _, e := range m.Elements() {
+				if predicate(e) {
+					want = append(want, e)
+				}
+			}
+			for _, e := range want {
+				m.Erase(e)
+			}
+			for _, e := range erased {
+				e.isUsed = false
+			}
+			verifyResult(t, "EraseIf", erased, want)
+
+		case oracleOpSwap:
+			tree.Swap(other)
+			models[treeIdx], models[otherIdx] = models[otherIdx], models[treeIdx]
+		}
+	}
+}
+
+// Compare full contents of every RbTree with its reference model in both directions
+func oracleCheck(t *testing.T, trees []*RbTree[fuzzEmbedItem], models []oracle.Set[*fuzzEmbedItem]) func(step int) {
+	return func(step int) {
+		for i, tree := range trees {
+			want := models[i].Elements()
+			forward := oracle.Collect(tree.Front(), tree.Next)
+			if diff := oracle.Diff(forward, want); diff != "" {
+				t.Fatalf("step %d: tree %d differs from model: %s", step, i, diff)
+			}
+			backward := oracle.Collect(tree.Back(), tree.Prev)
+			slices.Reverse(backward)
+			if diff := oracle.Diff(backward, want); diff != "" {
+				t.Fatalf("step %d: tree %d in reverse differs from model: %s", step, i, diff)
+			}
+			if tree.Len() != len(want) {
+				t.Fatalf("step %d: tree %d has size %d while model has %d", step, i, tree.Len(), len(want))
+			}
+		}
+	}
+}
+
+func FuzzRbTreeOracle(f *testing.F) {
+	const numItems = 64
+	const numTrees = 4
+
+	for _, seed := range oracle.Seeds(32, 512) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, commands []byte) {
+		items := make([]fuzzEmbedItem, numItems)
+		for i := range items {
+			items[i] = newFuzz(i%32, i)
+		}
+		trees := make([]*RbTree[fuzzEmbedItem], numTrees)
+		models := make([]oracle.Set[*fuzzEmbedItem], numTrees)
+		for i := range trees {
+			trees[i] = newFuzzRbTree()
+			models[i] = oracle.NewSet(lessFuzz)
+		}
+
+		oracle.Replay(commands, 4, oracleStep(t, items, trees, models), oracleCheck(t, trees, models))
+	})
+}
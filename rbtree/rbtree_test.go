@@ -0,0 +1,603 @@
+package rbtree
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// Test structures and helper functions similar to list tests
+
+type testEmbedItem struct {
+	Hook[testEmbedItem]
+	value int
+}
+
+func embedHook(self *testEmbedItem) *Hook[testEmbedItem] {
+	return &self.Hook
+}
+
+func embedLess(lhs, rhs *testEmbedItem) bool {
+	return lhs.value < rhs.value
+}
+
+func newEmbed(value int) *testEmbedItem {
+	return &testEmbedItem{Hook: NewHook[testEmbedItem](), value: value}
+}
+
+func newEmbedTree(values ...int) *RbTree[testEmbedItem] {
+	t := NewRbTree(embedHook, embedLess)
+	for _, v := range values {
+		t.Insert(newEmbed(v))
+	}
+	return t
+}
+
+func embedValues(elements []*testEmbedItem) []int {
+	values := make([]int, 0, len(elements))
+	for _, e := range elements {
+		values = append(values, e.value)
+	}
+	return values
+}
+
+func embedTreeValues(t *RbTree[testEmbedItem]) []int {
+	values := make([]int, 0, t.Len())
+	t.Traverse(func(e *testEmbedItem) { values = append(values, e.value) })
+	return values
+}
+
+type testMemberItem struct {
+	hook  Hook[testMemberItem]
+	value int
+}
+
+func memberHook(self *testMemberItem) *Hook[testMemberItem] {
+	return &self.hook
+}
+
+func memberLess(lhs, rhs *testMemberItem) bool {
+	return lhs.value < rhs.value
+}
+
+func newMember(value int) *testMemberItem {
+	return &testMemberItem{hook: NewHook[testMemberItem](), value: value}
+}
+
+func newMemberTree(values ...int) *RbTree[testMemberItem] {
+	t := NewRbTree(memberHook, memberLess)
+	for _, v := range values {
+		t.Insert(newMember(v))
+	}
+	return t
+}
+
+func memberValues(elements []*testMemberItem) []int {
+	values := make([]int, 0, len(elements))
+	for _, e := range elements {
+		values = append(values, e.value)
+	}
+	return values
+}
+
+func memberTreeValues(t *RbTree[testMemberItem]) []int {
+	values := make([]int, 0, t.Len())
+	t.Traverse(func(e *testMemberItem) { values = append(values, e.value) })
+	return values
+}
+
+func sequence(from, to int) []int {
+	values := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		values = append(values, i)
+	}
+	return values
+}
+
+/// Hook
+
+func TestRbTreeNewHookIsNotLinked(t *testing.T) {
+	h := NewHook[testEmbedItem]()
+	if h.left != nil || h.right != nil || h.parent != nil || h.color != black {
+		t.Errorf("new hook is not in empty state")
+	}
+	e := newEmbedTree(1, 2, 3)
+	root := e.root
+	elements := e.Clear()
+	for _, el := range elements {
+		if el.left != nil || el.right != nil || el.parent != nil {
+			t.Errorf("cleared element %v is still linked", el.value)
+		}
+	}
+	root.Init()
+	if root.color != black {
+		t.Errorf("initialized hook is not black")
+	}
+}
+
+/// Empty tree
+
+func TestRbTreeEmptyTree(t *testing.T) {
+	e := NewRbTree(embedHook, embedLess)
+	if !e.Empty() || e.Len() != 0 || e.Size() != 0 || e.Front() != nil || e.Back() != nil {
+		t.Errorf("new embedded element tree is not empty")
+	}
+	if e.Find(newEmbed(0)) != nil || e.Contains(newEmbed(0)) || e.LowerBound(newEmbed(0)) != nil || e.UpperBound(newEmbed(0)) != nil {
+		t.Errorf("new embedded element tree has elements")
+	}
+	if e.Next(nil) != nil || e.Prev(nil) != nil {
+		t.Errorf("embedded element tree iteration from nil returned element")
+	}
+	if e.Insert(nil) || e.Erase(nil) || e.Find(nil) != nil || e.LowerBound(nil) != nil || e.UpperBound(nil) != nil {
+		t.Errorf("embedded element tree accepted nil element")
+	}
+	if elements := e.Clear(); len(elements) != 0 {
+		t.Errorf("empty embedded element tree clear returned %v elements", len(elements))
+	}
+
+	m := New(memberHook, memberLess)
+	if !m.Empty() || m.Len() != 0 || m.Front() != nil || m.Back() != nil {
+		t.Errorf("new member element tree is not empty")
+	}
+	if err := m.Validate(); err != nil {
+		t.Errorf("new member element tree is invalid: %v", err)
+	}
+}
+
+/// Insert
+
+func TestRbTreeInsert(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		order  []int
+	}{
+		"single":     {[]int{1}, []int{1}},
+		"ascending":  {sequence(0, 10), sequence(0, 10)},
+		"descending": {[]int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, sequence(0, 10)},
+		"zigzag":     {[]int{5, 1, 9, 2, 8, 3, 7, 4, 6, 0}, sequence(0, 10)},
+		"duplicates": {[]int{3, 1, 3, 2, 1}, []int{1, 2, 3}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := NewRbTree(embedHook, embedLess)
+			for _, v := range testCase.values {
+				e.Insert(newEmbed(v))
+				if err := e.Validate(); err != nil {
+					t.Fatalf("embedded element tree is invalid after insert of %v: %v", v, err)
+				}
+			}
+			if v := embedTreeValues(e); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element tree has order %v", v)
+			}
+			if e.Front().value != testCase.order[0] || e.Back().value != testCase.order[len(testCase.order)-1] {
+				t.Errorf("embedded element tree has front %v and back %v", e.Front().value, e.Back().value)
+			}
+
+			m := NewRbTree(memberHook, memberLess)
+			for _, v := range testCase.values {
+				m.Insert(newMember(v))
+				if err := m.Validate(); err != nil {
+					t.Fatalf("member element tree is invalid after insert of %v: %v", v, err)
+				}
+			}
+			if v := memberTreeValues(m); !slices.Equal(v, testCase.order) || m.Len() != len(testCase.order) {
+				t.Errorf("member element tree has order %v", v)
+			}
+		})
+	}
+}
+
+func TestRbTreeInsertDuplicateIsRejected(t *testing.T) {
+	e := newEmbedTree(1, 2, 3)
+	d := newEmbed(2)
+	if e.Insert(d) || e.Len() != 3 || d.parent != nil || d.left != nil || d.right != nil {
+		t.Errorf("embedded element tree accepted duplicate")
+	}
+
+	m := newMemberTree(1, 2, 3)
+	md := newMember(3)
+	if m.Insert(md) || m.Len() != 3 || md.hook.parent != nil {
+		t.Errorf("member element tree accepted duplicate")
+	}
+}
+
+/// Erase
+
+func TestRbTreeErase(t *testing.T) {
+	tests := map[string]struct {
+		values []int
+		erase  int
+		order  []int
+	}{
+		"single":      {[]int{1}, 1, []int{}},
+		"front":       {sequence(0, 5), 0, []int{1, 2, 3, 4}},
+		"back":        {sequence(0, 5), 4, []int{0, 1, 2, 3}},
+		"root":        {[]int{4, 2, 6, 1, 3, 5, 7}, 4, []int{1, 2, 3, 5, 6, 7}},
+		"two-childs":  {[]int{4, 2, 6, 1, 3, 5, 7}, 2, []int{1, 3, 4, 5, 6, 7}},
+		"leaf":        {[]int{4, 2, 6, 1, 3, 5, 7}, 5, []int{1, 2, 3, 4, 6, 7}},
+		"inner-chain": {sequence(0, 16), 7, []int{0, 1, 2, 3, 4, 5, 6, 8, 9, 10, 11, 12, 13, 14, 15}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedTree(testCase.values...)
+			el := e.Find(newEmbed(testCase.erase))
+			if !e.Erase(el) {
+				t.Fatalf("embedded element tree erase failed")
+			}
+			if el.Hook != NewHook[testEmbedItem]() {
+				t.Errorf("erased embedded element hook is not in empty state")
+			}
+			if err := e.Validate(); err != nil {
+				t.Errorf("embedded element tree is invalid after erase: %v", err)
+			}
+			if v := embedTreeValues(e); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element tree after erase has order %v", v)
+			}
+			if len(testCase.order) == 0 && (e.Front() != nil || e.Back() != nil || !e.Empty()) {
+				t.Errorf("embedded element tree is not empty after erase of the last element")
+			}
+			if len(testCase.order) != 0 && (e.Front().value != testCase.order[0] || e.Back().value != testCase.order[len(testCase.order)-1]) {
+				t.Errorf("embedded element tree after erase has front %v and back %v", e.Front().value, e.Back().value)
+			}
+
+			m := newMemberTree(testCase.values...)
+			m.Erase(m.Find(newMember(testCase.erase)))
+			if err := m.Validate(); err != nil {
+				t.Errorf("member element tree is invalid after erase: %v", err)
+			}
+			if v := memberTreeValues(m); !slices.Equal(v, testCase.order) {
+				t.Errorf("member element tree after erase has order %v", v)
+			}
+		})
+	}
+}
+
+func TestRbTreeEraseInRandomOrder(t *testing.T) {
+	values := rand.Perm(200)
+	e := newEmbedTree(values...)
+	m := newMemberTree(values...)
+	for _, v := range rand.Perm(200) {
+		if !e.Erase(e.Find(newEmbed(v))) {
+			t.Fatalf("embedded element tree erase of %v failed", v)
+		}
+		if err := e.Validate(); err != nil {
+			t.Fatalf("embedded element tree is invalid after erase of %v: %v", v, err)
+		}
+		if !m.Erase(m.Find(newMember(v))) {
+			t.Fatalf("member element tree erase of %v failed", v)
+		}
+		if err := m.Validate(); err != nil {
+			t.Fatalf("member element tree is invalid after erase of %v: %v", v, err)
+		}
+	}
+	if !e.Empty() || !m.Empty() {
+		t.Errorf("trees are not empty after erase of all elements")
+	}
+}
+
+func TestRbTreeEraseIf(t *testing.T) {
+	e := newEmbedTree(sequence(0, 10)...)
+	erased := e.EraseIf(func(e *testEmbedItem) bool { return e.value%3 == 0 })
+	if v := embedValues(erased); !slices.Equal(v, []int{0, 3, 6, 9}) {
+		t.Errorf("embedded element tree eraseif returned %v", v)
+	}
+	if v := embedTreeValues(e); !slices.Equal(v, []int{1, 2, 4, 5, 7, 8}) {
+		t.Errorf("embedded element tree after eraseif has order %v", v)
+	}
+
+	m := newMemberTree(sequence(0, 5)...)
+	if erased := m.EraseIf(func(*testMemberItem) bool { return false }); len(erased) != 0 || m.Len() != 5 {
+		t.Errorf("member element tree eraseif with false predicate erased elements")
+	}
+	if erased := m.EraseIf(func(*testMemberItem) bool { return true }); len(erased) != 5 || !m.Empty() {
+		t.Errorf("member element tree eraseif with true predicate did not erase all elements")
+	}
+}
+
+/// Iteration and traversal
+
+func TestRbTreeNextAndPrev(t *testing.T) {
+	values := rand.Perm(50)
+	e := newEmbedTree(values...)
+	forward := make([]int, 0)
+	for el := e.Front(); el != nil; el = e.Next(el) {
+		forward = append(forward, el.value)
+	}
+	if !slices.Equal(forward, sequence(0, 50)) {
+		t.Errorf("embedded element tree forward iteration %v", forward)
+	}
+	backward := make([]int, 0)
+	for el := e.Back(); el != nil; el = e.Prev(el) {
+		backward = append(backward, el.value)
+	}
+	slices.Reverse(backward)
+	if !slices.Equal(backward, sequence(0, 50)) {
+		t.Errorf("embedded element tree backward iteration %v", backward)
+	}
+
+	m := newMemberTree(values...)
+	count := 0
+	for el := m.Back(); el != nil; el = m.Prev(el) {
+		count++
+	}
+	if count != 50 {
+		t.Errorf("member element tree backward iteration visited %v elements", count)
+	}
+}
+
+func TestRbTreeTraversals(t *testing.T) {
+	// Insertion in this order produces perfectly balanced tree without rotations
+	values := []int{4, 2, 6, 1, 3, 5, 7}
+	tests := map[string]struct {
+		traverse func(t *RbTree[testEmbedItem], f func(*testEmbedItem))
+		order    []int
+	}{
+		"in-order":   {(*RbTree[testEmbedItem]).Traverse, []int{1, 2, 3, 4, 5, 6, 7}},
+		"pre-order":  {(*RbTree[testEmbedItem]).TraversePreOrder, []int{4, 2, 1, 3, 6, 5, 7}},
+		"post-order": {(*RbTree[testEmbedItem]).TraversePostOrder, []int{1, 3, 2, 5, 7, 6, 4}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedTree(values...)
+			order := make([]int, 0)
+			testCase.traverse(e, func(el *testEmbedItem) { order = append(order, el.value) })
+			if !slices.Equal(order, testCase.order) {
+				t.Errorf("embedded element tree traversal has order %v", order)
+			}
+		})
+	}
+
+	m := newMemberTree(values...)
+	order := make([]int, 0)
+	m.TraversePreOrder(func(el *testMemberItem) { order = append(order, el.value) })
+	if !slices.Equal(order, tests["pre-order"].order) {
+		t.Errorf("member element tree pre-order traversal has order %v", order)
+	}
+}
+
+/// Search
+
+func TestRbTreeFindAndContains(t *testing.T) {
+	e := newEmbedTree(1, 3, 5, 7)
+	for _, v := range []int{1, 3, 5, 7} {
+		if f := e.Find(newEmbed(v)); f == nil || f.value != v || !e.Contains(newEmbed(v)) {
+			t.Errorf("embedded element tree find %v failed", v)
+		}
+	}
+	for _, v := range []int{0, 2, 8} {
+		if e.Find(newEmbed(v)) != nil || e.Contains(newEmbed(v)) {
+			t.Errorf("embedded element tree found absent %v", v)
+		}
+	}
+
+	m := newMemberTree(1, 3, 5, 7)
+	if !m.Contains(newMember(5)) || m.Contains(newMember(4)) {
+		t.Errorf("member element tree contains returned unexpected result")
+	}
+}
+
+func TestRbTreeBounds(t *testing.T) {
+	tests := map[string]struct {
+		value int
+		lower int
+		upper int
+	}{
+		"before-front": {5, 10, 10},
+		"front":        {10, 10, 20},
+		"between":      {15, 20, 20},
+		"back":         {30, 30, -1},
+		"after-back":   {35, -1, -1},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedTree(20, 10, 30)
+			lower, upper := e.LowerBound(newEmbed(testCase.value)), e.UpperBound(newEmbed(testCase.value))
+			if testCase.lower < 0 && lower != nil || testCase.lower >= 0 && (lower == nil || lower.value != testCase.lower) {
+				t.Errorf("embedded element tree lowerbound returned %v", lower)
+			}
+			if testCase.upper < 0 && upper != nil || testCase.upper >= 0 && (upper == nil || upper.value != testCase.upper) {
+				t.Errorf("embedded element tree upperbound returned %v", upper)
+			}
+
+			m := newMemberTree(20, 10, 30)
+			mlower, mupper := m.LowerBound(newMember(testCase.value)), m.UpperBound(newMember(testCase.value))
+			if testCase.lower < 0 && mlower != nil || testCase.lower >= 0 && (mlower == nil || mlower.value != testCase.lower) {
+				t.Errorf("member element tree lowerbound returned %v", mlower)
+			}
+			if testCase.upper < 0 && mupper != nil || testCase.upper >= 0 && (mupper == nil || mupper.value != testCase.upper) {
+				t.Errorf("member element tree upperbound returned %v", mupper)
+			}
+		})
+	}
+}
+
+/// Merge
+
+func TestRbTreeMerge(t *testing.T) {
+	tests := map[string]struct {
+		lhs, rhs  []int
+		merged    []int
+		remaining []int
+	}{
+		"empty-other": {[]int{1, 2}, []int{}, []int{1, 2}, []int{}},
+		"empty-this":  {[]int{}, []int{1, 2}, []int{1, 2}, []int{}},
+		"disjoint":    {[]int{1, 3, 5}, []int{2, 4, 6}, []int{1, 2, 3, 4, 5, 6}, []int{}},
+		"overlapping": {[]int{1, 2, 3}, []int{2, 3, 4}, []int{1, 2, 3, 4}, []int{2, 3}},
+		"equal":       {[]int{1, 2}, []int{1, 2}, []int{1, 2}, []int{1, 2}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e, other := newEmbedTree(testCase.lhs...), newEmbedTree(testCase.rhs...)
+			e.Merge(other)
+			if v := embedTreeValues(e); !slices.Equal(v, testCase.merged) {
+				t.Errorf("embedded element tree after merge has order %v", v)
+			}
+			if v := embedTreeValues(other); !slices.Equal(v, testCase.remaining) {
+				t.Errorf("embedded element other tree after merge has order %v", v)
+			}
+			if e.Validate() != nil || other.Validate() != nil {
+				t.Errorf("embedded element trees are invalid after merge")
+			}
+
+			m, mother := newMemberTree(testCase.lhs...), newMemberTree(testCase.rhs...)
+			m.Merge(mother)
+			if v := memberTreeValues(m); !slices.Equal(v, testCase.merged) {
+				t.Errorf("member element tree after merge has order %v", v)
+			}
+			if v := memberTreeValues(mother); !slices.Equal(v, testCase.remaining) {
+				t.Errorf("member element other tree after merge has order %v", v)
+			}
+		})
+	}
+
+	e := newEmbedTree(1)
+	e.Merge(nil)
+	if e.Len() != 1 {
+		t.Errorf("embedded element tree merge with nil changed tree")
+	}
+}
+
+/// Set algebra
+
+func TestRbTreeSetAlgebra(t *testing.T) {
+	tests := map[string]struct {
+		lhs, rhs     []int
+		includes     bool
+		difference   []int
+		intersection []int
+		symmetric    []int
+		union        []int
+	}{
+		"empty":     {[]int{}, []int{}, true, []int{}, []int{}, []int{}, []int{}},
+		"empty-rhs": {[]int{1, 2}, []int{}, true, []int{1, 2}, []int{}, []int{1, 2}, []int{1, 2}},
+		"empty-lhs": {[]int{}, []int{1, 2}, false, []int{}, []int{}, []int{1, 2}, []int{1, 2}},
+		"subset":    {[]int{1, 2, 3, 4}, []int{2, 3}, true, []int{1, 4}, []int{2, 3}, []int{1, 4}, []int{1, 2, 3, 4}},
+		"superset":  {[]int{2, 3}, []int{1, 2, 3, 4}, false, []int{}, []int{2, 3}, []int{1, 4}, []int{1, 2, 3, 4}},
+		"overlap":   {[]int{1, 2, 3}, []int{3, 4, 5}, false, []int{1, 2}, []int{3}, []int{1, 2, 4, 5}, []int{1, 2, 3, 4, 5}},
+		"disjoint":  {[]int{1, 3}, []int{0, 2, 4}, false, []int{1, 3}, []int{}, []int{0, 1, 2, 3, 4}, []int{0, 1, 2, 3, 4}},
+		"equal":     {[]int{1, 2}, []int{1, 2}, true, []int{}, []int{1, 2}, []int{}, []int{1, 2}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e, other := newEmbedTree(testCase.lhs...), newEmbedTree(testCase.rhs...)
+			if e.Includes(other) != testCase.includes {
+				t.Errorf("embedded element tree includes returned %v", !testCase.includes)
+			}
+			if v := embedValues(e.Difference(other)); !slices.Equal(v, testCase.difference) {
+				t.Errorf("embedded element tree difference is %v", v)
+			}
+			if v := embedValues(e.Intersection(other)); !slices.Equal(v, testCase.intersection) {
+				t.Errorf("embedded element tree intersection is %v", v)
+			}
+			if v := embedValues(e.SymDifference(other)); !slices.Equal(v, testCase.symmetric) {
+				t.Errorf("embedded element tree symmetric difference is %v", v)
+			}
+			if v := embedValues(e.Union(other)); !slices.Equal(v, testCase.union) {
+				t.Errorf("embedded element tree union is %v", v)
+			}
+
+			m, mother := newMemberTree(testCase.lhs...), newMemberTree(testCase.rhs...)
+			if m.Includes(mother) != testCase.includes {
+				t.Errorf("member element tree includes returned %v", !testCase.includes)
+			}
+			if v := memberValues(m.Difference(mother)); !slices.Equal(v, testCase.difference) {
+				t.Errorf("member element tree difference is %v", v)
+			}
+			if v := memberValues(m.Intersection(mother)); !slices.Equal(v, testCase.intersection) {
+				t.Errorf("member element tree intersection is %v", v)
+			}
+			if v := memberValues(m.SymDifference(mother)); !slices.Equal(v, testCase.symmetric) {
+				t.Errorf("member element tree symmetric difference is %v", v)
+			}
+			if v := memberValues(m.Union(mother)); !slices.Equal(v, testCase.union) {
+				t.Errorf("member element tree union is %v", v)
+			}
+		})
+	}
+}
+
+func TestRbTreeSetAlgebraWithNil(t *testing.T) {
+	e := newEmbedTree(1, 2)
+	if e.Includes(nil) || e.Difference(nil) != nil || e.Intersection(nil) != nil || e.SymDifference(nil) != nil || e.Union(nil) != nil {
+		t.Errorf("embedded element tree set algebra with nil returned unexpected result")
+	}
+}
+
+/// Swap, Clear and Init
+
+func TestRbTreeSwap(t *testing.T) {
+	e, other := newEmbedTree(1, 2, 3), newEmbedTree(10)
+	e.Swap(other)
+	if v := embedTreeValues(e); !slices.Equal(v, []int{10}) || e.Front().value != 10 || e.Back().value != 10 {
+		t.Errorf("embedded element tree after swap has order %v", v)
+	}
+	if v := embedTreeValues(other); !slices.Equal(v, []int{1, 2, 3}) || other.Len() != 3 {
+		t.Errorf("embedded element other tree after swap has order %v", v)
+	}
+	e.Swap(nil)
+	if e.Len() != 1 {
+		t.Errorf("embedded element tree swap with nil changed tree")
+	}
+
+	m, mother := newMemberTree(1, 2), NewRbTree(memberHook, memberLess)
+	m.Swap(mother)
+	if !m.Empty() || mother.Len() != 2 || mother.Validate() != nil {
+		t.Errorf("member element tree swap with empty tree has sizes %v %v", m.Len(), mother.Len())
+	}
+}
+
+func TestRbTreeClear(t *testing.T) {
+	e := newEmbedTree(rand.Perm(20)...)
+	elements := e.Clear()
+	if len(elements) != 20 || !e.Empty() || e.Front() != nil || e.Back() != nil {
+		t.Errorf("embedded element tree clear returned %v elements", len(elements))
+	}
+	for _, el := range elements {
+		if el.left != nil || el.right != nil || el.parent != nil {
+			t.Errorf("cleared embedded element %v is still linked", el.value)
+		}
+		e.Insert(el)
+	}
+	if e.Len() != 20 || e.Validate() != nil {
+		t.Errorf("embedded element tree is invalid after reinsertion of cleared elements")
+	}
+
+	m := newMemberTree(1, 2, 3)
+	m.Init()
+	if !m.Empty() || m.Front() != nil || m.Back() != nil {
+		t.Errorf("member element tree is not empty after init")
+	}
+}
+
+func TestRbTreeNewReturnsUsableValue(t *testing.T) {
+	e := New(embedHook, embedLess)
+	for _, v := range rand.Perm(30) {
+		e.Insert(newEmbed(v))
+	}
+	if err := e.Validate(); err != nil || e.Len() != 30 {
+		t.Errorf("tree created with New is invalid: %v", err)
+	}
+}
+
+func TestRbTreeStructureAccessors(t *testing.T) {
+	e := newEmbedTree(2, 1, 3)
+	root := e.Root()
+	if root == nil || root.value != 2 || e.Parent(root) != nil || e.IsRed(root) {
+		t.Fatalf("embedded element tree has incorrect root %v", root)
+	}
+	left, right := e.Left(root), e.Right(root)
+	if left == nil || left.value != 1 || e.Parent(left) != root || !e.IsRed(left) {
+		t.Errorf("embedded element tree has incorrect left child %v", left)
+	}
+	if right == nil || right.value != 3 || e.Parent(right) != root || !e.IsRed(right) {
+		t.Errorf("embedded element tree has incorrect right child %v", right)
+	}
+	if e.Left(left) != nil || e.Right(left) != nil || e.IsRed(nil) {
+		t.Errorf("embedded element tree leaf has children")
+	}
+
+	m := newMemberTree()
+	if m.Root() != nil {
+		t.Errorf("empty member element tree has root")
+	}
+}
@@ -0,0 +1,78 @@
+package rbtree
+
+import (
+	"fmt"
+)
+
+type (
+	// SafeHook contains tree structure information for a value and tracks
+	// RbTree the value is linked into.
+	//
+	// RbTree created with NewSafeRbTree panics with ErrAlreadyLinked on insertion
+	// of linked element and with ErrNotMember on erase of element of other container,
+	// in all builds
+	SafeHook[T any] struct {
+		Hook[T]
+		owner *RbTree[T]
+	}
+)
+
+// Init initializes safe-mode hook to empty state.
+//
+// WARNING: Calling this function on linked SafeHook will damage RbTree structure
+func (h *SafeHook[T]) Init() {
+	h.Hook.Init()
+	h.owner = nil
+}
+
+// NewSafeHook creates a new initialized SafeHook
+func NewSafeHook[T any]() SafeHook[T] {
+	return SafeHook[T]{Hook: NewHook[T](), owner: nil}
+}
+
+// IsLinked returns true if value of this hook is part of some RbTree
+func (h SafeHook[T]) IsLinked() bool {
+	return h.owner != nil
+}
+
+// Owner returns RbTree that value of this hook is part of or nil if it is not linked
+func (h SafeHook[T]) Owner() *RbTree[T] {
+	return h.owner
+}
+
+// NewSafeRbTree creates a new Red-Black Tree of elements with SafeHook.
+//
+// Each linked element stores the address of the tree, so the tree
+// must not be copied while it is not empty. Swap updates owner of every
+// element of both trees and as a result has linear complexity
+func NewSafeRbTree[T any](hookFunc func(*T) *SafeHook[T], lessFunc func(*T, *T) bool) *RbTree[T] {
+	return &RbTree[T]{
+		hookFunc:  func(e *T) *Hook[T] { return &hookFunc(e).Hook },
+		ownerFunc: func(e *T) **RbTree[T] { return &hookFunc(e).owner },
+		lessFunc:  lessFunc,
+	}
+}
+
+// setOwner sets owner of every node of the tree if tree tracks owners
+func (t *RbTree[T]) setOwner(owner *RbTree[T]) {
+	if t.ownerFunc == nil {
+		return
+	}
+	t.TraversePreOrder(func(node *T) {
+		*t.ownerFunc(node) = owner
+	})
+}
+
+// checkNotLinked panics with ErrAlreadyLinked if tree tracks owners and item is linked
+func (t *RbTree[T]) checkNotLinked(item *T) {
+	if t.ownerFunc != nil && *t.ownerFunc(item) != nil {
+		panic(fmt.Errorf("%w: RbTree %p element: %p", ErrAlreadyLinked, t, item))
+	}
+}
+
+// checkIsMember panics with ErrNotMember if tree tracks owners and item is not its member
+func (t *RbTree[T]) checkIsMember(item *T) {
+	if t.ownerFunc != nil && *t.ownerFunc(item) != t {
+		panic(fmt.Errorf("%w: RbTree %p element: %p", ErrNotMember, t, item))
+	}
+}
@@ -0,0 +1,112 @@
+package rbtree
+
+import (
+	"errors"
+	"testing"
+)
+
+type testSafeItem struct {
+	SafeHook[testSafeItem]
+	value int
+}
+
+func safeHook(self *testSafeItem) *SafeHook[testSafeItem] {
+	return &self.SafeHook
+}
+
+func safeLess(lhs, rhs *testSafeItem) bool {
+	return lhs.value < rhs.value
+}
+
+func newSafeTreeGenerate(values ...int) *RbTree[testSafeItem] {
+	t := NewSafeRbTree(safeHook, safeLess)
+	for _, v := range values {
+		t.Insert(&testSafeItem{SafeHook: NewSafeHook[testSafeItem](), value: v})
+	}
+	return t
+}
+
+func expectPanic(t *testing.T, target error, f func()) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, target) {
+			t.Errorf("expected panic with %v got %v", target, r)
+		}
+	}()
+	f()
+}
+
+func verifyOwner(t *testing.T, tree *RbTree[testSafeItem]) {
+	t.Helper()
+	tree.Traverse(func(e *testSafeItem) {
+		if e.Owner() != tree || !e.IsLinked() {
+			t.Errorf("element %v has owner %p instead of %p", e.value, e.Owner(), tree)
+		}
+	})
+}
+
+func TestSafeRbTreeIsLinked(t *testing.T) {
+	tree := NewSafeRbTree(safeHook, safeLess)
+	e := testSafeItem{SafeHook: NewSafeHook[testSafeItem](), value: 0}
+	if e.IsLinked() || e.Owner() != nil {
+		t.Errorf("new element is linked")
+	}
+	tree.Insert(&e)
+	if !e.IsLinked() || e.Owner() != tree {
+		t.Errorf("inserted element is not linked")
+	}
+	duplicate := testSafeItem{value: 0}
+	if tree.Insert(&duplicate) || duplicate.IsLinked() {
+		t.Errorf("duplicate element is linked")
+	}
+	tree.Erase(&e)
+	if e.IsLinked() {
+		t.Errorf("erased element is linked")
+	}
+}
+
+func TestSafeRbTreeDoubleInsertPanics(t *testing.T) {
+	tree := newSafeTreeGenerate(0, 1, 2)
+	other := newSafeTreeGenerate(10)
+	e := tree.Front()
+
+	expectPanic(t, ErrAlreadyLinked, func() { tree.Insert(e) })
+	expectPanic(t, ErrAlreadyLinked, func() { other.Insert(e) })
+	if tree.Len() != 3 || other.Len() != 1 || e.Owner() != tree {
+		t.Errorf("failed insertion changed trees %v %v", tree.Len(), other.Len())
+	}
+}
+
+func TestSafeRbTreeForeignErasePanics(t *testing.T) {
+	tree := newSafeTreeGenerate(0, 1, 2)
+	other := newSafeTreeGenerate(1)
+	free := testSafeItem{value: 2}
+
+	expectPanic(t, ErrNotMember, func() { tree.Erase(other.Front()) })
+	expectPanic(t, ErrNotMember, func() { tree.Erase(&free) })
+	if tree.Len() != 3 || other.Len() != 1 {
+		t.Errorf("failed erase changed trees %v %v", tree.Len(), other.Len())
+	}
+}
+
+func TestSafeRbTreeOwnerUpdatedOnTransfer(t *testing.T) {
+	a := newSafeTreeGenerate(0, 1, 2)
+	b := newSafeTreeGenerate(2, 3, 4, 5)
+	a.Merge(b)
+	verifyOwner(t, a)
+	verifyOwner(t, b)
+
+	a.Swap(b)
+	verifyOwner(t, a)
+	verifyOwner(t, b)
+	if a.Len() != 1 || b.Len() != 6 {
+		t.Errorf("unexpected sizes after transfer %v %v", a.Len(), b.Len())
+	}
+
+	for _, e := range b.Clear() {
+		if e.IsLinked() {
+			t.Errorf("cleared element %v is still linked", e.value)
+		}
+	}
+}
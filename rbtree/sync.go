@@ -0,0 +1,82 @@
+package rbtree
+
+import (
+	"github.com/echo-Mike/intrusive/internal/pkg/guard"
+)
+
+type (
+	// SyncRbTree is RbTree guarded by read-write mutex that can be shared between goroutines.
+	//
+	// Hooks of linked elements are modified only under write lock, so elements
+	// MUST NOT be accessed through their hooks without holding the lock (see Do and View).
+	// Len and Empty don't take the lock.
+	// SyncRbTree MUST NOT be copied after first use
+	SyncRbTree[T any] struct {
+		guard.Guarded[*RbTree[T]]
+		tree RbTree[T]
+	}
+)
+
+// NewSyncRbTree creates a new SyncRbTree taking ownership of tree. Tree SHOULD be empty
+// as elements of tree created with NewSafeRbTree store the address of the tree and
+// have to be updated one by one
+func NewSyncRbTree[T any](tree RbTree[T]) *SyncRbTree[T] {
+	s := &SyncRbTree[T]{tree: tree}
+	s.tree.setOwner(&s.tree)
+	guard.Init(&s.Guarded, &s.tree)
+	return s
+}
+
+// Front returns the smallest element of tree
+func (s *SyncRbTree[T]) Front() (front *T) {
+	s.View(func(tree *RbTree[T]) { front = tree.Front() })
+	return
+}
+
+// Back returns the largest element of tree
+func (s *SyncRbTree[T]) Back() (back *T) {
+	s.View(func(tree *RbTree[T]) { back = tree.Back() })
+	return
+}
+
+// Insert adds a new node to the tree
+func (s *SyncRbTree[T]) Insert(item *T) (inserted bool) {
+	s.Do(func(tree *RbTree[T]) { inserted = tree.Insert(item) })
+	return
+}
+
+// Erase removes a node from the tree
+func (s *SyncRbTree[T]) Erase(item *T) (erased bool) {
+	s.Do(func(tree *RbTree[T]) { erased = tree.Erase(item) })
+	return
+}
+
+// Contains checks if element that compares equal with item exists in tree
+func (s *SyncRbTree[T]) Contains(item *T) (found bool) {
+	s.View(func(tree *RbTree[T]) { found = tree.Contains(item) })
+	return
+}
+
+// Find searches for an element that compares equal with item
+func (s *SyncRbTree[T]) Find(item *T) (found *T) {
+	s.View(func(tree *RbTree[T]) { found = tree.Find(item) })
+	return
+}
+
+// LowerBound finds first element not less than item
+func (s *SyncRbTree[T]) LowerBound(item *T) (bound *T) {
+	s.View(func(tree *RbTree[T]) { bound = tree.LowerBound(item) })
+	return
+}
+
+// UpperBound finds first element greater than item
+func (s *SyncRbTree[T]) UpperBound(item *T) (bound *T) {
+	s.View(func(tree *RbTree[T]) { bound = tree.UpperBound(item) })
+	return
+}
+
+// Clear removes all nodes from the tree
+func (s *SyncRbTree[T]) Clear() (nodes []*T) {
+	s.Do(func(tree *RbTree[T]) { nodes = tree.Clear() })
+	return
+}
@@ -0,0 +1,128 @@
+package rbtree
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncRbTreeConcurrentInsertErase(t *testing.T) {
+	const workers = 8
+	const perWorker = 100
+	items := make([]*testEmbedItem, workers*perWorker)
+	for i := range items {
+		items[i] = newEmbed(i)
+	}
+	s := NewSyncRbTree(New(embedHook, embedLess))
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(items); i += workers {
+				if !s.Insert(items[i]) {
+					t.Errorf("insert of unique element %v failed", i)
+				}
+				if s.Find(newEmbed(i)) != items[i] {
+					t.Errorf("inserted element %v is not found", i)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	if s.Len() != len(items) {
+		t.Fatalf("tree has size %v after concurrent insert instead of %v", s.Len(), len(items))
+	}
+	s.View(func(tree *RbTree[testEmbedItem]) {
+		if err := tree.Validate(); err != nil {
+			t.Fatalf("tree is invalid after concurrent insert: %v", err)
+		}
+	})
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(items); i += workers {
+				if i%2 == 0 && !s.Erase(items[i]) {
+					t.Errorf("erase of element %v failed", i)
+				}
+				if lb := s.LowerBound(newEmbed(i)); lb == nil || lb.value < i {
+					t.Errorf("lower bound of %v is incorrect", i)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	if s.Len() != len(items)/2 || s.Contains(items[0]) || !s.Contains(items[1]) {
+		t.Errorf("tree has incorrect content after concurrent erase")
+	}
+	if f, b := s.Front(), s.Back(); f != items[1] || b != items[len(items)-1] {
+		t.Errorf("tree has incorrect front %v or back %v", f, b)
+	}
+	if ub := s.UpperBound(newEmbed(1)); ub != items[3] {
+		t.Errorf("upper bound of 1 is %v", ub)
+	}
+	if nodes := s.Clear(); len(nodes) != len(items)/2 || !s.Empty() {
+		t.Errorf("clear of synchronized tree failed")
+	}
+}
+
+func TestSyncRbTreeDoAndView(t *testing.T) {
+	const moves = 1000
+	s := NewSyncRbTree(*newEmbedTree(sequence(0, 64)...))
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < moves; i++ {
+				s.Do(func(tree *RbTree[testEmbedItem]) {
+					e := tree.Front()
+					tree.Erase(e)
+					e.value = tree.Back().value + 1
+					tree.Insert(e)
+				})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < moves; i++ {
+				s.View(func(tree *RbTree[testEmbedItem]) {
+					if err := tree.Validate(); err != nil {
+						t.Errorf("tree is invalid during view: %v", err)
+					}
+					if tree.Back().value-tree.Front().value != 63 {
+						t.Errorf("view observed intermediate state")
+					}
+				})
+				if s.Len() != 64 {
+					t.Errorf("Len observed intermediate size %v", s.Len())
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestSyncRbTreeSafeOwner(t *testing.T) {
+	s := NewSyncRbTree(*newSafeTreeGenerate(0, 1, 2))
+	item := &testSafeItem{SafeHook: NewSafeHook[testSafeItem](), value: 3}
+	if !s.Insert(item) || s.Len() != 4 {
+		t.Errorf("insert into synchronized tree failed")
+	}
+	s.View(func(tree *RbTree[testSafeItem]) {
+		verifyOwner(t, tree)
+		if err := tree.Validate(); err != nil {
+			t.Errorf("synchronized tree is invalid: %v", err)
+		}
+	})
+	front := s.Front()
+	if !s.Erase(front) || front.IsLinked() || s.Len() != 3 {
+		t.Errorf("erase from synchronized tree failed")
+	}
+	if nodes := s.Clear(); len(nodes) != 3 || s.Len() != 0 || item.IsLinked() {
+		t.Errorf("clear of synchronized tree failed")
+	}
+}
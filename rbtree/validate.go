@@ -0,0 +1,90 @@
+package rbtree
+
+// Validate checks integrity of the tree and returns *ValidationError describing
+// the first detected violation or nil if the tree is consistent.
+// Unlike debug verifiers it is available in all builds.
+//
+// Complexity is linear in size of the tree
+func (t *RbTree[T]) Validate() error {
+	if t.root == nil {
+		if t.first != nil || t.last != nil {
+			return &ValidationError[T]{Err: ErrBrokenLink, Element: nil}
+		}
+		if t.size != 0 {
+			return &ValidationError[T]{Err: ErrSizeMismatch, Element: nil}
+		}
+		return nil
+	}
+	if t.parent(t.root) != nil {
+		return &ValidationError[T]{Err: ErrParentPointer, Element: t.root}
+	}
+	if t.color(t.root) != black {
+		return &ValidationError[T]{Err: ErrRedViolation, Element: t.root}
+	}
+
+	v := validator[T]{tree: t}
+	if _, err := v.validate(t.root); err != nil {
+		return err
+	}
+	if v.count != t.size {
+		return &ValidationError[T]{Err: ErrSizeMismatch, Element: nil}
+	}
+	if t.first != t.min(t.root) {
+		return &ValidationError[T]{Err: ErrBrokenLink, Element: t.first}
+	}
+	if t.last != v.prev {
+		return &ValidationError[T]{Err: ErrBrokenLink, Element: t.last}
+	}
+	return nil
+}
+
+// validator holds state of in-order validation traversal
+type validator[T any] struct {
+	tree  *RbTree[T]
+	prev  *T
+	count int
+}
+
+// validate checks subtree of node in-order and returns its black height.
+// As parent pointers of every child are checked there can be no cycles in checked subtree
+func (v *validator[T]) validate(node *T) (int, error) {
+	if node == nil {
+		return 1, nil
+	}
+	t := v.tree
+	left, right := t.left(node), t.right(node)
+	if left != nil && t.parent(left) != node {
+		return 0, &ValidationError[T]{Err: ErrParentPointer, Element: left}
+	}
+	if right != nil && t.parent(right) != node {
+		return 0, &ValidationError[T]{Err: ErrParentPointer, Element: right}
+	}
+	if t.color(node) == red && (t.color(left) == red || t.color(right) == red) {
+		return 0, &ValidationError[T]{Err: ErrRedViolation, Element: node}
+	}
+
+	leftHeight, err := v.validate(left)
+	if err != nil {
+		return 0, err
+	}
+	if v.prev != nil && !t.lessFunc(v.prev, node) {
+		return 0, &ValidationError[T]{Err: ErrOrder, Element: node}
+	}
+	if t.ownerFunc != nil && *t.ownerFunc(node) != t {
+		return 0, &ValidationError[T]{Err: ErrNotMember, Element: node}
+	}
+	v.prev = node
+	v.count++
+	rightHeight, err := v.validate(right)
+	if err != nil {
+		return 0, err
+	}
+
+	if leftHeight != rightHeight {
+		return 0, &ValidationError[T]{Err: ErrBlackHeight, Element: node}
+	}
+	if t.color(node) == black {
+		leftHeight++
+	}
+	return leftHeight, nil
+}
@@ -0,0 +1,82 @@
+package rbtree
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRbTreeValidateValidTree(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 7, 64} {
+		values := make([]int, size)
+		for i := range values {
+			values[i] = (i * 37) % size
+		}
+		tree := newSafeTreeGenerate(values...)
+		if err := tree.Validate(); err != nil {
+			t.Errorf("tree of size %v is invalid: %v", size, err)
+		}
+	}
+}
+
+func TestRbTreeValidateDetectsCorruption(t *testing.T) {
+	tests := map[string]struct {
+		corrupt func(tree *RbTree[testSafeItem]) *testSafeItem
+		err     error
+	}{
+		"parent": {func(tree *RbTree[testSafeItem]) *testSafeItem {
+			left := tree.left(tree.root)
+			tree.setParent(left, left)
+			return left
+		}, ErrParentPointer},
+		"root-parent": {func(tree *RbTree[testSafeItem]) *testSafeItem {
+			tree.setParent(tree.root, tree.first)
+			return tree.root
+		}, ErrParentPointer},
+		"red-root": {func(tree *RbTree[testSafeItem]) *testSafeItem {
+			tree.setColor(tree.root, red)
+			return tree.root
+		}, ErrRedViolation},
+		"black-height": {func(tree *RbTree[testSafeItem]) *testSafeItem {
+			tree.setColor(tree.first, red)
+			return tree.parent(tree.first)
+		}, ErrBlackHeight},
+		"order": {func(tree *RbTree[testSafeItem]) *testSafeItem {
+			tree.first.value = 100
+			return nil
+		}, ErrOrder},
+		"size": {func(tree *RbTree[testSafeItem]) *testSafeItem {
+			tree.size++
+			return nil
+		}, ErrSizeMismatch},
+		"first": {func(tree *RbTree[testSafeItem]) *testSafeItem {
+			tree.first = tree.last
+			return tree.last
+		}, ErrBrokenLink},
+		"owner": {func(tree *RbTree[testSafeItem]) *testSafeItem {
+			tree.last.owner = nil
+			return tree.last
+		}, ErrNotMember},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Perfectly balanced tree with all nodes black
+			tree := newSafeTreeGenerate(3, 1, 5, 0, 2, 4, 6)
+			tree.Traverse(func(e *testSafeItem) { tree.setColor(e, black) })
+			if err := tree.Validate(); err != nil {
+				t.Fatalf("tree is invalid before corruption: %v", err)
+			}
+			element := testCase.corrupt(tree)
+			err := tree.Validate()
+			if !errors.Is(err, testCase.err) {
+				t.Fatalf("expected %v got %v", testCase.err, err)
+			}
+			var validationErr *ValidationError[testSafeItem]
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("error is not ValidationError %v", err)
+			}
+			if element != nil && validationErr.Element != element {
+				t.Errorf("unexpected offending element %p instead of %p", validationErr.Element, element)
+			}
+		})
+	}
+}
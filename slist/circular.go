@@ -0,0 +1,185 @@
+package slist
+
+type (
+	// Head structure of circular singly-linked list intrusive container.
+	//
+	// Circular uses the same Hook as SList but links of its elements are never nil:
+	// the last element points to the first one. Only the last element is stored in head
+	// as the first one is always reachable from it
+	Circular[T any] struct {
+		hookFunc  func(*T) *Hook[T]
+		offset    uintptr
+		useOffset bool
+		size      int
+		last      *T
+	}
+)
+
+// Create new Circular container
+func NewCircular[T any](hookFunc func(*T) *Hook[T]) Circular[T] {
+	return Circular[T]{hookFunc: hookFunc, size: 0, last: nil}
+}
+
+// Initialize Circular to empty state
+func (c *Circular[T]) Init() {
+	c.last = nil
+	c.size = 0
+}
+
+// Check if Circular is empty
+func (c Circular[T]) Empty() bool {
+	return c.size == 0
+}
+
+// Get current length of Circular
+func (c Circular[T]) Size() int {
+	return c.size
+}
+
+// Get current length of Circular
+func (c Circular[T]) Len() int {
+	return c.size
+}
+
+// Swap content of two Circular heads
+func (c *Circular[T]) Swap(other *Circular[T]) {
+	other.hookFunc, c.hookFunc = c.hookFunc, other.hookFunc
+	other.offset, c.offset = c.offset, other.offset
+	other.useOffset, c.useOffset = c.useOffset, other.useOffset
+	other.last, c.last = c.last, other.last
+	other.size, c.size = c.size, other.size
+}
+
+// Return first element in Circular
+func (c Circular[T]) Front() *T {
+	if c.last == nil {
+		return nil
+	}
+	return c.hook(c.last).next
+}
+
+// Return last element in Circular
+func (c Circular[T]) Back() *T {
+	return c.last
+}
+
+// Insert new element after specified. Position SHOULD be part of current Circular
+func (c *Circular[T]) InsertAfter(position, element *T) {
+	c.verifyNotEmpty()
+	c.verifyElementNotLinked(element)
+	c.verifyIsMemberOfCurrent(position)
+	defer c.verifyIsMemberOfCurrent(element)
+	defer c.verifyLinks()
+	defer c.verifySize()
+
+	c.hook(element).next = c.hook(position).next
+	c.hook(position).next = element
+	if c.last == position {
+		c.last = element
+	}
+	c.size++
+}
+
+// Unlink and return element after specified. Position SHOULD be part of current Circular.
+//
+// As there is no end in Circular element after the last one is the first one
+// and element after the single element is the element itself
+func (c *Circular[T]) RemoveAfter(position *T) (popped *T) {
+	c.verifyNotEmpty()
+	c.verifyIsMemberOfCurrent(position)
+	defer c.verifyLinks()
+	defer c.verifySize()
+
+	popped = c.hook(position).next
+	if popped == position {
+		c.last = nil
+	} else {
+		c.hook(position).next = c.hook(popped).next
+		if c.last == popped {
+			c.last = position
+		}
+	}
+	c.size--
+	c.hook(popped).Init()
+	return
+}
+
+// Move elements from other Circular to be included in current Circular after position
+func (c *Circular[T]) SpliceAfter(position *T, other *Circular[T]) {
+	if other.last == nil || c == other {
+		return
+	}
+	other.verifyNotEmpty()
+	other.verifyLinks()
+	c.verifyNotEmpty()
+	c.verifyIsMemberOfCurrent(position)
+	defer c.verifyLinks()
+	defer c.verifySize()
+
+	otherFirst := c.hook(other.last).next
+	c.hook(other.last).next = c.hook(position).next
+	c.hook(position).next = otherFirst
+	if c.last == position {
+		c.last = other.last
+	}
+	c.size += other.size
+	other.Init()
+}
+
+// Insert new element at the front of Circular
+func (c *Circular[T]) PushFront(element *T) {
+	c.verifyElementNotLinked(element)
+	defer c.verifyIsMemberOfCurrent(element)
+	defer c.verifyLinks()
+	defer c.verifySize()
+
+	if c.last == nil {
+		c.hook(element).next = element
+		c.last = element
+	} else {
+		c.hook(element).next = c.hook(c.last).next
+		c.hook(c.last).next = element
+	}
+	c.size++
+}
+
+// Remove and return element from the front of Circular
+func (c *Circular[T]) PopFront() (popped *T) {
+	if c.last == nil {
+		return nil
+	}
+	return c.RemoveAfter(c.last)
+}
+
+// Insert new element at the back of Circular
+func (c *Circular[T]) PushBack(element *T) {
+	c.PushFront(element)
+	c.last = element
+}
+
+// Move the first element of Circular to its back
+func (c *Circular[T]) Rotate() {
+	if c.last == nil {
+		return
+	}
+	c.last = c.hook(c.last).next
+}
+
+// Clear Circular and return all currently linked elements as slice
+func (c *Circular[T]) Clear() (elements []*T) {
+	c.verifyLinks()
+
+	elements = make([]*T, 0, c.size)
+	if c.last == nil {
+		return
+	}
+	e := c.hook(c.last).next
+	for i := 0; i < c.size; i++ {
+		elements = append(elements, e)
+		h := c.hook(e)
+		e = h.Next()
+		h.Init()
+	}
+	c.Init()
+	return
+}
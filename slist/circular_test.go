@@ -0,0 +1,290 @@
+package slist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/fn"
+)
+
+func newEmbedCircularGenerate(count int, generator func(position int) int) (c Circular[testEmbedItem]) {
+	c = NewCircular(embedHook)
+	for i := 0; i < count; i++ {
+		item := newEmbed(generator(i))
+		c.PushBack(&item)
+	}
+	return
+}
+
+func nextEmbedCircular(c Circular[testEmbedItem]) func() int {
+	first := c.Front()
+	return func() int {
+		defer func() { first = first.Next() }()
+		return first.value
+	}
+}
+
+func newMemberCircularGenerate(count int, generator func(position int) int) (c Circular[testMemberItem]) {
+	c = NewCircular(memberHook)
+	for i := 0; i < count; i++ {
+		item := newMember(generator(i))
+		c.PushBack(&item)
+	}
+	return
+}
+
+func nextMemberCircular(c Circular[testMemberItem]) func() int {
+	first := c.Front()
+	return func() int {
+		defer func() { first = first.hook.Next() }()
+		return first.value
+	}
+}
+
+/// Circular list
+
+func TestCircularEmptyListHasNoFrontAndBack(t *testing.T) {
+	e := NewCircular(embedHook)
+	if f, b := e.Front(), e.Back(); f != nil || b != nil || !e.Empty() || e.Len() != 0 {
+		t.Errorf("new embedded element circular list is not empty %p %p %v", f, b, e.Len())
+	}
+	if p := e.PopFront(); p != nil {
+		t.Errorf("new embedded element circular list pop front returned %p", p)
+	}
+	e.Rotate()
+
+	m := NewCircular(memberHook)
+	if f, b := m.Front(), m.Back(); f != nil || b != nil || !m.Empty() || m.Len() != 0 {
+		t.Errorf("new member element circular list is not empty %p %p %v", f, b, m.Len())
+	}
+	if p := m.PopFront(); p != nil {
+		t.Errorf("new member element circular list pop front returned %p", p)
+	}
+	m.Rotate()
+}
+
+func TestCircularOneElementListLinksToItself(t *testing.T) {
+	e := NewCircular(embedHook)
+	el := newEmbed(0)
+	e.PushFront(&el)
+	if el.Next() != &el || e.Front() != &el || e.Back() != &el {
+		t.Errorf("embedded element circular list single element is not linked to itself")
+	}
+	if p := e.RemoveAfter(&el); p != &el || !e.Empty() || el.Next() != nil {
+		t.Errorf("embedded element circular list remove after single element did not remove it")
+	}
+
+	m := NewCircular(memberHook)
+	ml := newMember(0)
+	m.PushBack(&ml)
+	if ml.hook.Next() != &ml || m.Front() != &ml || m.Back() != &ml {
+		t.Errorf("member element circular list single element is not linked to itself")
+	}
+	if p := m.PopFront(); p != &ml || !m.Empty() || ml.hook.Next() != nil {
+		t.Errorf("member element circular list pop front single element did not remove it")
+	}
+}
+
+func TestCircularBackLinksToFront(t *testing.T) {
+	e := newEmbedCircularGenerate(3, increment(0))
+	if e.Back().Next() != e.Front() {
+		t.Errorf("embedded element circular list back is not linked to front")
+	}
+	if v := fn.Apply(nextEmbedCircular(e), fn.I, 6); !slices.Equal(v, []int{0, 1, 2, 0, 1, 2}) {
+		t.Errorf("embedded element circular list iteration do not wrap %v", v)
+	}
+
+	m := newMemberCircularGenerate(3, increment(0))
+	if m.Back().hook.Next() != m.Front() {
+		t.Errorf("member element circular list back is not linked to front")
+	}
+	if v := fn.Apply(nextMemberCircular(m), fn.I, 6); !slices.Equal(v, []int{0, 1, 2, 0, 1, 2}) {
+		t.Errorf("member element circular list iteration do not wrap %v", v)
+	}
+}
+
+func TestCircularPushFrontAndPushBack(t *testing.T) {
+	e := newEmbedCircularGenerate(2, increment(0))
+	f, b := newEmbed(10), newEmbed(20)
+	e.PushFront(&f)
+	e.PushBack(&b)
+	if v := fn.Apply(nextEmbedCircular(e), fn.I, e.Len()); !slices.Equal(v, []int{10, 0, 1, 20}) {
+		t.Errorf("embedded element circular list has unexpected order %v", v)
+	}
+	if e.Front() != &f || e.Back() != &b {
+		t.Errorf("embedded element circular list has unexpected front and back")
+	}
+
+	m := newMemberCircularGenerate(2, increment(0))
+	mf, mb := newMember(10), newMember(20)
+	m.PushFront(&mf)
+	m.PushBack(&mb)
+	if v := fn.Apply(nextMemberCircular(m), fn.I, m.Len()); !slices.Equal(v, []int{10, 0, 1, 20}) {
+		t.Errorf("member element circular list has unexpected order %v", v)
+	}
+}
+
+func TestCircularInsertAfter(t *testing.T) {
+	tests := map[string]struct {
+		listSize int
+		at       int
+		order    []int
+	}{
+		"1-0": {1, 0, []int{0, 10}},
+		"2-0": {2, 0, []int{0, 10, 1}},
+		"2-1": {2, 1, []int{0, 1, 10}},
+		"3-1": {3, 1, []int{0, 1, 10, 2}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedCircularGenerate(testCase.listSize, increment(0))
+			el := newEmbed(10)
+			pos := e.Front()
+			for i := 0; i < testCase.at; i++ {
+				pos = pos.Next()
+			}
+			e.InsertAfter(pos, &el)
+			if v := fn.Apply(nextEmbedCircular(e), fn.I, e.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element circular list after insertafter has order %v", v)
+			}
+			if testCase.at == testCase.listSize-1 && e.Back() != &el {
+				t.Errorf("embedded element circular list insertafter back did not change back")
+			}
+
+			m := newMemberCircularGenerate(testCase.listSize, increment(0))
+			ml := newMember(10)
+			mpos := m.Front()
+			for i := 0; i < testCase.at; i++ {
+				mpos = mpos.hook.Next()
+			}
+			m.InsertAfter(mpos, &ml)
+			if v := fn.Apply(nextMemberCircular(m), fn.I, m.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("member element circular list after insertafter has order %v", v)
+			}
+		})
+	}
+}
+
+func TestCircularRemoveAfter(t *testing.T) {
+	tests := map[string]struct {
+		listSize int
+		at       int
+		removed  int
+		order    []int
+	}{
+		"2-0": {2, 0, 1, []int{0}},
+		"2-1": {2, 1, 0, []int{1}},
+		"3-1": {3, 1, 2, []int{0, 1}},
+		"3-2": {3, 2, 0, []int{1, 2}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedCircularGenerate(testCase.listSize, increment(0))
+			pos := e.Front()
+			for i := 0; i < testCase.at; i++ {
+				pos = pos.Next()
+			}
+			if p := e.RemoveAfter(pos); p.value != testCase.removed || p.Next() != nil {
+				t.Errorf("embedded element circular list removeafter removed %v", p.value)
+			}
+			if v := fn.Apply(nextEmbedCircular(e), fn.I, e.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element circular list after removeafter has order %v", v)
+			}
+			if e.Back().value != testCase.order[len(testCase.order)-1] {
+				t.Errorf("embedded element circular list after removeafter has back %v", e.Back().value)
+			}
+
+			m := newMemberCircularGenerate(testCase.listSize, increment(0))
+			mpos := m.Front()
+			for i := 0; i < testCase.at; i++ {
+				mpos = mpos.hook.Next()
+			}
+			if p := m.RemoveAfter(mpos); p.value != testCase.removed {
+				t.Errorf("member element circular list removeafter removed %v", p.value)
+			}
+			if v := fn.Apply(nextMemberCircular(m), fn.I, m.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("member element circular list after removeafter has order %v", v)
+			}
+		})
+	}
+}
+
+func TestCircularSpliceAfter(t *testing.T) {
+	tests := map[string]struct {
+		listSizes [2]int
+		at        int
+		order     []int
+	}{
+		"1-0-0": {[2]int{1, 0}, 0, []int{0}},
+		"1-2-0": {[2]int{1, 2}, 0, []int{0, 10, 11}},
+		"2-2-0": {[2]int{2, 2}, 0, []int{0, 10, 11, 1}},
+		"2-2-1": {[2]int{2, 2}, 1, []int{0, 1, 10, 11}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedCircularGenerate(testCase.listSizes[0], increment(0))
+			other := newEmbedCircularGenerate(testCase.listSizes[1], increment(10))
+			pos := e.Front()
+			for i := 0; i < testCase.at; i++ {
+				pos = pos.Next()
+			}
+			e.SpliceAfter(pos, &other)
+			if v := fn.Apply(nextEmbedCircular(e), fn.I, e.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element circular list after spliceafter has order %v", v)
+			}
+			if e.Back().value != testCase.order[len(testCase.order)-1] {
+				t.Errorf("embedded element circular list after spliceafter has back %v", e.Back().value)
+			}
+			if !other.Empty() || other.Back() != nil {
+				t.Errorf("embedded element circular list after spliceafter other is not empty")
+			}
+
+			m := newMemberCircularGenerate(testCase.listSizes[0], increment(0))
+			mother := newMemberCircularGenerate(testCase.listSizes[1], increment(10))
+			mpos := m.Front()
+			for i := 0; i < testCase.at; i++ {
+				mpos = mpos.hook.Next()
+			}
+			m.SpliceAfter(mpos, &mother)
+			if v := fn.Apply(nextMemberCircular(m), fn.I, m.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("member element circular list after spliceafter has order %v", v)
+			}
+		})
+	}
+}
+
+func TestCircularRotateMovesFrontToBack(t *testing.T) {
+	e := newEmbedCircularGenerate(3, increment(0))
+	e.Rotate()
+	if v := fn.Apply(nextEmbedCircular(e), fn.I, e.Len()); !slices.Equal(v, []int{1, 2, 0}) {
+		t.Errorf("embedded element circular list after rotate has order %v", v)
+	}
+
+	m := newMemberCircularGenerate(3, increment(0))
+	m.Rotate()
+	m.Rotate()
+	if v := fn.Apply(nextMemberCircular(m), fn.I, m.Len()); !slices.Equal(v, []int{2, 0, 1}) {
+		t.Errorf("member element circular list after rotate has order %v", v)
+	}
+}
+
+func TestCircularClearAndSwap(t *testing.T) {
+	e := newEmbedCircularGenerate(3, increment(0))
+	other := newEmbedCircularGenerate(1, increment(10))
+	e.Swap(&other)
+	if e.Len() != 1 || other.Len() != 3 {
+		t.Errorf("embedded element circular list swap has sizes %v %v", e.Len(), other.Len())
+	}
+	elements := other.Clear()
+	if len(elements) != 3 || !other.Empty() {
+		t.Errorf("embedded element circular list clear returned %v elements", len(elements))
+	}
+	if fn.AnyOf(elements, func(e *testEmbedItem) bool { return e.Next() != nil }) {
+		t.Errorf("embedded element circular list clear returned linked elements")
+	}
+
+	m := newMemberCircularGenerate(2, increment(0))
+	if elements := m.Clear(); len(elements) != 2 || elements[0].value != 0 || elements[1].value != 1 {
+		t.Errorf("member element circular list clear returned unexpected elements")
+	}
+}
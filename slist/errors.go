@@ -0,0 +1,43 @@
+package slist
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// Error that safe-mode SList panics with when already linked element is inserted
+	ErrAlreadyLinked = errors.New("slist: element is already linked")
+	// Error that safe-mode SList panics with when element of other container is removed or used as position
+	ErrNotMember = errors.New("slist: element is not a member of the list")
+	// Error that SList panics with when elements are moved between safe-mode and plain SList
+	ErrModeMismatch = errors.New("slist: safe-mode and plain lists are mixed")
+	// Error returned by Validate when elements of SList form a cycle
+	ErrCycle = errors.New("slist: cycle detected")
+	// Error returned by Validate when number of linked elements is not equal to the size of SList
+	ErrSizeMismatch = errors.New("slist: size mismatch")
+	// Error returned by Validate when links of element or head of SList are inconsistent
+	ErrBrokenLink = errors.New("slist: broken link")
+)
+
+type (
+	// Error returned by Validate that holds the offending element.
+	// Element MAY be nil if error is not related to a particular element
+	ValidationError[T any] struct {
+		Err     error
+		Element *T
+	}
+)
+
+// Return error description with address of the offending element
+func (e *ValidationError[T]) Error() string {
+	if e.Element == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%v: element: %p", e.Err, e.Element)
+}
+
+// Return underlying sentinel error
+func (e *ValidationError[T]) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,155 @@
+package slist
+
+type (
+	// Head structure of minimal singly-linked list intrusive container.
+	//
+	// Linear uses the same Hook as SList but stores only the first element in head:
+	// there is no cached last element nor size. Operations that require them
+	// (Len, SpliceAfter) walk the list and have linear complexity
+	Linear[T any] struct {
+		hookFunc  func(*T) *Hook[T]
+		offset    uintptr
+		useOffset bool
+		first     *T
+	}
+)
+
+// Create new Linear container
+func NewLinear[T any](hookFunc func(*T) *Hook[T]) Linear[T] {
+	return Linear[T]{hookFunc: hookFunc, first: nil}
+}
+
+// Initialize Linear to empty state
+func (l *Linear[T]) Init() {
+	l.first = nil
+}
+
+// Check if Linear is empty
+func (l Linear[T]) Empty() bool {
+	return l.first == nil
+}
+
+// Get current length of Linear. Complexity is linear in size of the list
+func (l Linear[T]) Size() (size int) {
+	for e := l.first; e != nil; e = l.hook(e).next {
+		size++
+	}
+	return
+}
+
+// Get current length of Linear. Complexity is linear in size of the list
+func (l Linear[T]) Len() int {
+	return l.Size()
+}
+
+// Swap content of two Linear heads
+func (l *Linear[T]) Swap(other *Linear[T]) {
+	other.hookFunc, l.hookFunc = l.hookFunc, other.hookFunc
+	other.offset, l.offset = l.offset, other.offset
+	other.useOffset, l.useOffset = l.useOffset, other.useOffset
+	other.first, l.first = l.first, other.first
+}
+
+// Return first element in Linear
+func (l Linear[T]) Front() *T {
+	return l.first
+}
+
+// Insert new element after specified. Position SHOULD be part of current Linear
+func (l *Linear[T]) InsertAfter(position, element *T) {
+	l.verifyElementNotLinked(element)
+	l.verifyIsMemberOfCurrent(position)
+	defer l.verifyIsMemberOfCurrent(element)
+	defer l.verifyNoCycle()
+
+	l.hook(element).next = l.hook(position).next
+	l.hook(position).next = element
+}
+
+// Unlink and return element after specified. Position SHOULD be part of current Linear. Return nil if position is at the end of Linear
+func (l *Linear[T]) RemoveAfter(position *T) (popped *T) {
+	l.verifyIsMemberOfCurrent(position)
+	defer l.verifyNoCycle()
+
+	if popped = l.hook(position).next; popped != nil {
+		l.hook(position).next = l.hook(popped).next
+		l.hook(popped).Init()
+	}
+	return
+}
+
+// Move elements from other Linear to be included in current Linear after position.
+//
+// Complexity is linear in size of other
+func (l *Linear[T]) SpliceAfter(position *T, other *Linear[T]) {
+	if other.first == nil || l == other {
+		return
+	}
+	other.verifyNoCycle()
+	l.verifyIsMemberOfCurrent(position)
+	defer l.verifyNoCycle()
+
+	last := other.first
+	for next := l.hook(last).next; next != nil; next = l.hook(last).next {
+		last = next
+	}
+	l.hook(last).next = l.hook(position).next
+	l.hook(position).next = other.first
+	other.Init()
+}
+
+// Insert new element at the front of Linear
+func (l *Linear[T]) PushFront(element *T) {
+	l.verifyElementNotLinked(element)
+	defer l.verifyIsMemberOfCurrent(element)
+	defer l.verifyNoCycle()
+
+	l.hook(element).next = l.first
+	l.first = element
+}
+
+// Remove and return element from the front of Linear
+func (l *Linear[T]) PopFront() (popped *T) {
+	if l.first == nil {
+		return nil
+	}
+	defer l.verifyNoCycle()
+
+	popped = l.first
+	l.first = l.hook(popped).next
+	l.hook(popped).Init()
+	return
+}
+
+// Reverse current Linear in place
+func (l *Linear[T]) Reverse() {
+	l.verifyNoCycle()
+	defer l.verifyNoCycle()
+
+	var prev *T = nil
+	e := l.first
+	for e != nil {
+		next := l.hook(e).next
+		l.hook(e).next = prev
+		prev = e
+		e = next
+	}
+	l.first = prev
+}
+
+// Clear Linear and return all currently linked elements as slice.
+// Use Init() to clear Linear without allocations
+func (l *Linear[T]) Clear() (elements []*T) {
+	l.verifyNoCycle()
+
+	elements = make([]*T, 0)
+	e := l.first
+	for e != nil {
+		elements = append(elements, e)
+		h := l.hook(e)
+		e = h.Next()
+		h.Init()
+	}
+	l.Init()
+	return
+}
@@ -0,0 +1,203 @@
+package slist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/fn"
+)
+
+func newEmbedLinearGenerate(count int, generator func(position int) int) (l Linear[testEmbedItem]) {
+	l = NewLinear(embedHook)
+	for i := count - 1; i >= 0; i-- {
+		item := newEmbed(generator(i))
+		l.PushFront(&item)
+	}
+	return
+}
+
+func nextEmbedLinear(l Linear[testEmbedItem]) func() int {
+	first := l.Front()
+	return func() int {
+		defer func() { first = first.Next() }()
+		return first.value
+	}
+}
+
+func newMemberLinearGenerate(count int, generator func(position int) int) (l Linear[testMemberItem]) {
+	l = NewLinear(memberHook)
+	for i := count - 1; i >= 0; i-- {
+		item := newMember(generator(i))
+		l.PushFront(&item)
+	}
+	return
+}
+
+func nextMemberLinear(l Linear[testMemberItem]) func() int {
+	first := l.Front()
+	return func() int {
+		defer func() { first = first.hook.Next() }()
+		return first.value
+	}
+}
+
+/// Linear list
+
+func TestLinearEmptyListHasNoFront(t *testing.T) {
+	e := NewLinear(embedHook)
+	if f := e.Front(); f != nil || !e.Empty() || e.Len() != 0 {
+		t.Errorf("new embedded element linear list is not empty %p %v", f, e.Len())
+	}
+	if p := e.PopFront(); p != nil {
+		t.Errorf("new embedded element linear list pop front returned %p", p)
+	}
+
+	m := NewLinear(memberHook)
+	if f := m.Front(); f != nil || !m.Empty() || m.Len() != 0 {
+		t.Errorf("new member element linear list is not empty %p %v", f, m.Len())
+	}
+	if p := m.PopFront(); p != nil {
+		t.Errorf("new member element linear list pop front returned %p", p)
+	}
+}
+
+func TestLinearPushFrontAndPopFront(t *testing.T) {
+	e := newEmbedLinearGenerate(3, increment(0))
+	if v := fn.Apply(nextEmbedLinear(e), fn.I, e.Len()); !slices.Equal(v, []int{0, 1, 2}) {
+		t.Errorf("embedded element linear list has order %v", v)
+	}
+	if p := e.PopFront(); p.value != 0 || p.Next() != nil || e.Len() != 2 {
+		t.Errorf("embedded element linear list pop front returned %v", p.value)
+	}
+
+	m := newMemberLinearGenerate(3, increment(0))
+	if v := fn.Apply(nextMemberLinear(m), fn.I, m.Len()); !slices.Equal(v, []int{0, 1, 2}) {
+		t.Errorf("member element linear list has order %v", v)
+	}
+	if p := m.PopFront(); p.value != 0 || p.hook.Next() != nil || m.Len() != 2 {
+		t.Errorf("member element linear list pop front returned %v", p.value)
+	}
+}
+
+func TestLinearInsertAfterAndRemoveAfter(t *testing.T) {
+	tests := map[string]struct {
+		listSize int
+		at       int
+		order    []int
+	}{
+		"1-0": {1, 0, []int{0, 10}},
+		"2-0": {2, 0, []int{0, 10, 1}},
+		"2-1": {2, 1, []int{0, 1, 10}},
+		"3-1": {3, 1, []int{0, 1, 10, 2}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedLinearGenerate(testCase.listSize, increment(0))
+			el := newEmbed(10)
+			pos := e.Front()
+			for i := 0; i < testCase.at; i++ {
+				pos = pos.Next()
+			}
+			e.InsertAfter(pos, &el)
+			if v := fn.Apply(nextEmbedLinear(e), fn.I, e.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element linear list after insertafter has order %v", v)
+			}
+			if p := e.RemoveAfter(pos); p != &el || e.Len() != testCase.listSize {
+				t.Errorf("embedded element linear list removeafter did not remove inserted element")
+			}
+
+			m := newMemberLinearGenerate(testCase.listSize, increment(0))
+			ml := newMember(10)
+			mpos := m.Front()
+			for i := 0; i < testCase.at; i++ {
+				mpos = mpos.hook.Next()
+			}
+			m.InsertAfter(mpos, &ml)
+			if v := fn.Apply(nextMemberLinear(m), fn.I, m.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("member element linear list after insertafter has order %v", v)
+			}
+			if p := m.RemoveAfter(mpos); p != &ml || m.Len() != testCase.listSize {
+				t.Errorf("member element linear list removeafter did not remove inserted element")
+			}
+		})
+	}
+}
+
+func TestLinearRemoveAfterLastReturnsNil(t *testing.T) {
+	e := newEmbedLinearGenerate(2, increment(0))
+	if p := e.RemoveAfter(e.Front().Next()); p != nil || e.Len() != 2 {
+		t.Errorf("embedded element linear list removeafter last returned %p", p)
+	}
+
+	m := newMemberLinearGenerate(1, increment(0))
+	if p := m.RemoveAfter(m.Front()); p != nil || m.Len() != 1 {
+		t.Errorf("member element linear list removeafter last returned %p", p)
+	}
+}
+
+func TestLinearSpliceAfter(t *testing.T) {
+	tests := map[string]struct {
+		listSizes [2]int
+		at        int
+		order     []int
+	}{
+		"1-0-0": {[2]int{1, 0}, 0, []int{0}},
+		"1-2-0": {[2]int{1, 2}, 0, []int{0, 10, 11}},
+		"2-2-0": {[2]int{2, 2}, 0, []int{0, 10, 11, 1}},
+		"2-2-1": {[2]int{2, 2}, 1, []int{0, 1, 10, 11}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedLinearGenerate(testCase.listSizes[0], increment(0))
+			other := newEmbedLinearGenerate(testCase.listSizes[1], increment(10))
+			pos := e.Front()
+			for i := 0; i < testCase.at; i++ {
+				pos = pos.Next()
+			}
+			e.SpliceAfter(pos, &other)
+			if v := fn.Apply(nextEmbedLinear(e), fn.I, e.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element linear list after spliceafter has order %v", v)
+			}
+			if !other.Empty() {
+				t.Errorf("embedded element linear list after spliceafter other is not empty")
+			}
+
+			m := newMemberLinearGenerate(testCase.listSizes[0], increment(0))
+			mother := newMemberLinearGenerate(testCase.listSizes[1], increment(10))
+			mpos := m.Front()
+			for i := 0; i < testCase.at; i++ {
+				mpos = mpos.hook.Next()
+			}
+			m.SpliceAfter(mpos, &mother)
+			if v := fn.Apply(nextMemberLinear(m), fn.I, m.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("member element linear list after spliceafter has order %v", v)
+			}
+		})
+	}
+}
+
+func TestLinearReverseClearAndSwap(t *testing.T) {
+	e := newEmbedLinearGenerate(3, increment(0))
+	e.Reverse()
+	if v := fn.Apply(nextEmbedLinear(e), fn.I, e.Len()); !slices.Equal(v, []int{2, 1, 0}) {
+		t.Errorf("embedded element linear list after reverse has order %v", v)
+	}
+	other := NewLinear(embedHook)
+	e.Swap(&other)
+	if !e.Empty() || other.Len() != 3 {
+		t.Errorf("embedded element linear list swap has sizes %v %v", e.Len(), other.Len())
+	}
+	elements := other.Clear()
+	if len(elements) != 3 || !other.Empty() {
+		t.Errorf("embedded element linear list clear returned %v elements", len(elements))
+	}
+	if fn.AnyOf(elements, func(e *testEmbedItem) bool { return e.Next() != nil }) {
+		t.Errorf("embedded element linear list clear returned linked elements")
+	}
+
+	m := newMemberLinearGenerate(2, increment(0))
+	m.Reverse()
+	if elements := m.Clear(); len(elements) != 2 || elements[0].value != 1 || elements[1].value != 0 {
+		t.Errorf("member element linear list clear returned unexpected elements")
+	}
+}
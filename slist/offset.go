@@ -0,0 +1,48 @@
+package slist
+
+import (
+	"unsafe"
+)
+
+// Create new SList container of elements which Hook is located at offset from the start of element.
+//
+// Offset SHOULD be computed with unsafe.Offsetof, e.g. unsafe.Offsetof(item.hook).
+// Hook is accessed with pointer arithmetic instead of a call of hookFunc on every step
+// of every operation
+func NewOffset[T any](offset uintptr) SList[T] {
+	return SList[T]{hookFunc: nil, offset: offset, useOffset: true, size: 0, first: nil, last: nil}
+}
+
+// Return hook of element using hookFunc or offset if SList is created with NewOffset
+func (s *SList[T]) hook(element *T) *Hook[T] {
+	if s.useOffset {
+		return (*Hook[T])(unsafe.Add(unsafe.Pointer(element), s.offset))
+	}
+	return s.hookFunc(element)
+}
+
+// Create new Circular container of elements which Hook is located at offset from the start of element
+func NewCircularOffset[T any](offset uintptr) Circular[T] {
+	return Circular[T]{hookFunc: nil, offset: offset, useOffset: true, size: 0, last: nil}
+}
+
+// Return hook of element using hookFunc or offset if Circular is created with NewCircularOffset
+func (c *Circular[T]) hook(element *T) *Hook[T] {
+	if c.useOffset {
+		return (*Hook[T])(unsafe.Add(unsafe.Pointer(element), c.offset))
+	}
+	return c.hookFunc(element)
+}
+
+// Create new Linear container of elements which Hook is located at offset from the start of element
+func NewLinearOffset[T any](offset uintptr) Linear[T] {
+	return Linear[T]{hookFunc: nil, offset: offset, useOffset: true, first: nil}
+}
+
+// Return hook of element using hookFunc or offset if Linear is created with NewLinearOffset
+func (l *Linear[T]) hook(element *T) *Hook[T] {
+	if l.useOffset {
+		return (*Hook[T])(unsafe.Add(unsafe.Pointer(element), l.offset))
+	}
+	return l.hookFunc(element)
+}
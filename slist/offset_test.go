@@ -0,0 +1,151 @@
+package slist
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/fn"
+)
+
+// Hook is intentionally not the first field to test non-zero offset
+type testOffsetItem struct {
+	value int
+	hook  Hook[testOffsetItem]
+}
+
+var testOffset = unsafe.Offsetof(testOffsetItem{}.hook)
+
+func offsetHook(self *testOffsetItem) *Hook[testOffsetItem] {
+	return &self.hook
+}
+
+func newOffsetListGenerate(count int, generator func(position int) int) (l SList[testOffsetItem]) {
+	l = NewOffset[testOffsetItem](testOffset)
+	for i := 0; i < count; i++ {
+		l.PushBack(&testOffsetItem{value: generator(i)})
+	}
+	return
+}
+
+func nextOffset(l SList[testOffsetItem]) func() int {
+	current := l.Front()
+	return func() int {
+		defer func() { current = current.hook.Next() }()
+		return current.value
+	}
+}
+
+func lessOffset(lhs, rhs *testOffsetItem) bool {
+	return lhs.value < rhs.value
+}
+
+func TestOffsetSListPushAndPop(t *testing.T) {
+	l := newOffsetListGenerate(3, increment(0))
+	f := testOffsetItem{value: -1}
+	l.PushFront(&f)
+	l.InsertAfter(l.Back(), &testOffsetItem{value: 3})
+	if v := fn.Apply(nextOffset(l), fn.I, l.Len()); !slices.Equal(v, []int{-1, 0, 1, 2, 3}) {
+		t.Errorf("offset list has order %v", v)
+	}
+	if p := l.PopFront(); p != &f || f.hook.Next() != nil {
+		t.Errorf("offset list pop front returned unexpected element")
+	}
+	if err := l.Validate(); err != nil {
+		t.Errorf("offset list is invalid: %v", err)
+	}
+}
+
+func TestOffsetSListSortAndSplit(t *testing.T) {
+	l := newOffsetListGenerate(100, func(int) int { return rand.Intn(50) })
+	l.Sort(lessOffset)
+	if !isSorted(nextOffset(l), l.Len()) {
+		t.Errorf("offset list is not sorted")
+	}
+	tail := l.SplitAfter(l.Median())
+	if l.Len() != 50 || tail.Len() != 50 {
+		t.Errorf("offset list split has sizes %v %v", l.Len(), tail.Len())
+	}
+	if err := tail.Validate(); err != nil {
+		t.Errorf("tail of offset list is invalid: %v", err)
+	}
+	if !isSorted(nextOffset(tail), tail.Len()) {
+		t.Errorf("tail of offset list is not sorted")
+	}
+}
+
+func TestOffsetCircularAndLinear(t *testing.T) {
+	c := NewCircularOffset[testOffsetItem](testOffset)
+	for i := 0; i < 3; i++ {
+		c.PushBack(&testOffsetItem{value: i})
+	}
+	c.Rotate()
+	if c.Len() != 3 || c.Front().value != 1 || c.Back().value != 0 || c.Back().hook.Next().value != 1 {
+		t.Errorf("offset circular has front %v and back %v", c.Front().value, c.Back().value)
+	}
+
+	l := NewLinearOffset[testOffsetItem](testOffset)
+	for i := 0; i < 3; i++ {
+		l.PushFront(&testOffsetItem{value: i})
+	}
+	l.Reverse()
+	if v := fn.Apply(nextLinearOffset(l), fn.I, l.Len()); !slices.Equal(v, []int{0, 1, 2}) {
+		t.Errorf("offset linear after reverse has order %v", v)
+	}
+	if p := l.PopFront(); p.value != 0 || l.Len() != 2 {
+		t.Errorf("offset linear popped %v", p.value)
+	}
+}
+
+func nextLinearOffset(l Linear[testOffsetItem]) func() int {
+	current := l.Front()
+	return func() int {
+		defer func() { current = current.hook.Next() }()
+		return current.value
+	}
+}
+
+// Zero value SList has neither hookFunc nor offset and MUST NOT access memory at offset 0
+func TestOffsetZeroValueSListPanics(t *testing.T) {
+	var l SList[testOffsetItem]
+	item := testOffsetItem{value: 1}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("zero value SList accepted element")
+		}
+		if item.value != 1 || item.hook != (Hook[testOffsetItem]{}) {
+			t.Errorf("zero value SList modified memory of element")
+		}
+	}()
+	l.PushBack(&item)
+}
+
+func benchmarkSListSort(b *testing.B, size int, newList func() SList[testOffsetItem]) {
+	items := make([]testOffsetItem, size)
+	values := rand.Perm(size)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		l := newList()
+		for j := range items {
+			items[j] = testOffsetItem{value: values[j]}
+			l.PushBack(&items[j])
+		}
+		b.StartTimer()
+		l.Sort(lessOffset)
+	}
+}
+
+func BenchmarkSListSort(b *testing.B) {
+	for _, size := range []int{100, 10000} {
+		b.Run(fmt.Sprintf("hookFunc/%d", size), func(b *testing.B) {
+			benchmarkSListSort(b, size, func() SList[testOffsetItem] { return New(offsetHook) })
+		})
+		b.Run(fmt.Sprintf("offset/%d", size), func(b *testing.B) {
+			benchmarkSListSort(b, size, func() SList[testOffsetItem] { return NewOffset[testOffsetItem](testOffset) })
+		})
+	}
+}
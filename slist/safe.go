@@ -0,0 +1,95 @@
+package slist
+
+import (
+	"fmt"
+)
+
+type (
+	// Hook structure to insert/embed into concrete types of elements
+	// of singly-linked list intrusive container that tracks SList it is linked into.
+	//
+	// SList created with NewSafe panics with ErrAlreadyLinked on insertion of linked element
+	// and with ErrNotMember when element of other container is used, in all builds
+	SafeHook[T any] struct {
+		Hook[T]
+		owner *SList[T]
+	}
+)
+
+// Initialize hook to empty state.
+//
+// WARNING: Calling this function on linked SafeHook will damage SList structure
+func (h *SafeHook[T]) Init() {
+	h.Hook.Init()
+	h.owner = nil
+}
+
+// Create safe-mode hook in empty state
+func NewSafeHook[T any]() SafeHook[T] {
+	return SafeHook[T]{Hook: NewHook[T](), owner: nil}
+}
+
+// Check if element of this hook is part of some SList
+func (h SafeHook[T]) IsLinked() bool {
+	return h.owner != nil
+}
+
+// Return SList that element of this hook is part of or nil if it is not linked
+func (h SafeHook[T]) Owner() *SList[T] {
+	return h.owner
+}
+
+// Create new SList container of elements with SafeHook.
+//
+// Each linked element stores the address of the SList head, so the head
+// MUST NOT be copied or moved while it is not empty. Operations that move elements
+// between heads (Swap, SpliceAfter, SpliceAfterRange, SplitAfterInto, Merge) update owner
+// of every moved element and as a result have linear complexity in number of moved elements
+func NewSafe[T any](hookFunc func(*T) *SafeHook[T]) SList[T] {
+	return SList[T]{
+		hookFunc:  func(e *T) *Hook[T] { return &hookFunc(e).Hook },
+		ownerFunc: func(e *T) **SList[T] { return &hookFunc(e).owner },
+		size:      0,
+		first:     nil,
+		last:      nil,
+	}
+}
+
+// Set owner of elements in range [first, last] if current SList tracks owners
+func (s *SList[T]) setOwner(first, last *T, owner *SList[T]) {
+	if s.ownerFunc == nil || first == nil {
+		return
+	}
+	for e := first; ; e = s.hook(e).next {
+		*s.ownerFunc(e) = owner
+		if e == last {
+			return
+		}
+	}
+}
+
+// Panic with ErrAlreadyLinked if current SList tracks owners and element is linked
+func (s *SList[T]) checkNotLinked(element *T) {
+	if s.ownerFunc != nil && *s.ownerFunc(element) != nil {
+		panic(fmt.Errorf("%w: SList %p element: %p", ErrAlreadyLinked, s, element))
+	}
+}
+
+// Panic with ErrNotMember if current SList tracks owners and element is not its member
+func (s *SList[T]) checkIsMember(element *T) {
+	if s.ownerFunc != nil && *s.ownerFunc(element) != s {
+		panic(fmt.Errorf("%w: SList %p element: %p", ErrNotMember, s, element))
+	}
+}
+
+// Panic with ErrModeMismatch if only one of current and other SList tracks owners
+// and with ErrNotMember if boundary elements of other are not its members
+func (s *SList[T]) checkSource(other *SList[T]) {
+	if (s.ownerFunc == nil) != (other.ownerFunc == nil) {
+		panic(fmt.Errorf("%w: SList %p other: %p", ErrModeMismatch, s, other))
+	}
+	if other.first != nil {
+		other.checkIsMember(other.first)
+		other.checkIsMember(other.last)
+	}
+}
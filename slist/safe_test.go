@@ -0,0 +1,176 @@
+package slist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/fn"
+)
+
+type testSafeItem struct {
+	SafeHook[testSafeItem]
+	value int
+}
+
+func safeHook(self *testSafeItem) *SafeHook[testSafeItem] {
+	return &self.SafeHook
+}
+
+// Safe-mode SList head must not be copied after elements are inserted
+func newSafeListGenerate(count int, generator func(position int) int) (l *SList[testSafeItem]) {
+	l = new(SList[testSafeItem])
+	*l = NewSafe(safeHook)
+	for i := 0; i < count; i++ {
+		item := testSafeItem{SafeHook: NewSafeHook[testSafeItem](), value: generator(i)}
+		l.PushBack(&item)
+	}
+	return
+}
+
+func nextSafe(l *SList[testSafeItem]) func() int {
+	current := l.Front()
+	return func() int {
+		defer func() { current = current.Next() }()
+		return current.value
+	}
+}
+
+func verifyOwner(t *testing.T, l *SList[testSafeItem]) {
+	t.Helper()
+	for e := l.Front(); e != nil; e = e.Next() {
+		if e.Owner() != l || !e.IsLinked() {
+			t.Errorf("element %v has owner %p instead of %p", e.value, e.Owner(), l)
+		}
+	}
+}
+
+func expectPanic(t *testing.T, target error, f func()) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, target) {
+			t.Errorf("expected panic with %v got %v", target, r)
+		}
+	}()
+	f()
+}
+
+func TestSafeSListIsLinked(t *testing.T) {
+	l := NewSafe(safeHook)
+	e := testSafeItem{SafeHook: NewSafeHook[testSafeItem](), value: 0}
+	if e.IsLinked() || e.Owner() != nil {
+		t.Errorf("new element is linked")
+	}
+	l.PushFront(&e)
+	if !e.IsLinked() || e.Owner() != &l {
+		t.Errorf("pushed element is not linked")
+	}
+	l.PopFront()
+	if e.IsLinked() {
+		t.Errorf("popped element is linked")
+	}
+	l.PushBack(&e)
+	f := testSafeItem{value: 1}
+	l.InsertAfter(&e, &f)
+	verifyOwner(t, &l)
+	if p := l.RemoveAfter(&e); p != &f || f.IsLinked() {
+		t.Errorf("removed element is linked")
+	}
+}
+
+func TestSafeSListDoubleInsertPanics(t *testing.T) {
+	l := newSafeListGenerate(2, increment(0))
+	other := newSafeListGenerate(1, increment(10))
+	e := l.Front()
+
+	expectPanic(t, ErrAlreadyLinked, func() { l.PushBack(e) })
+	expectPanic(t, ErrAlreadyLinked, func() { l.PushFront(e) })
+	expectPanic(t, ErrAlreadyLinked, func() { other.InsertAfter(other.Front(), e) })
+	if l.Len() != 2 || other.Len() != 1 || e.Owner() != l {
+		t.Errorf("failed insertion changed lists %v %v", l.Len(), other.Len())
+	}
+}
+
+func TestSafeSListForeignElementPanics(t *testing.T) {
+	l := newSafeListGenerate(2, increment(0))
+	other := newSafeListGenerate(2, increment(10))
+	free := testSafeItem{value: 20}
+
+	expectPanic(t, ErrNotMember, func() { l.RemoveAfter(other.Front()) })
+	expectPanic(t, ErrNotMember, func() { l.InsertAfter(other.Front(), &free) })
+	expectPanic(t, ErrNotMember, func() { l.SpliceAfter(other.Front(), newSafeListGenerate(1, increment(30))) })
+	expectPanic(t, ErrNotMember, func() { l.SpliceAfterRange(nil, other, nil, l.Back()) })
+	expectPanic(t, ErrNotMember, func() { l.SplitAfterInto(other.Front(), newSafeListGenerate(0, increment(0))) })
+	if l.Len() != 2 || other.Len() != 2 || free.IsLinked() {
+		t.Errorf("failed operation changed lists %v %v", l.Len(), other.Len())
+	}
+}
+
+func TestSafeSListOwnerUpdatedOnTransfer(t *testing.T) {
+	a := newSafeListGenerate(3, increment(0))
+	b := newSafeListGenerate(3, increment(10))
+	a.SpliceAfter(a.Front(), b)
+	verifyOwner(t, a)
+
+	c := newSafeListGenerate(2, increment(20))
+	c.SpliceAfterRange(nil, a, a.Front(), a.Front().Next().Next())
+	verifyOwner(t, a)
+	verifyOwner(t, c)
+
+	d := newSafeListGenerate(2, increment(0))
+	d.Merge(c, func(lhs, rhs *testSafeItem) bool { return lhs.value < rhs.value })
+	verifyOwner(t, d)
+
+	d.Swap(a)
+	verifyOwner(t, a)
+	verifyOwner(t, d)
+
+	tail := newSafeListGenerate(1, increment(30))
+	a.SplitAfterInto(a.Front().Next(), tail)
+	verifyOwner(t, a)
+	verifyOwner(t, tail)
+	if v := fn.Apply(nextSafe(tail), fn.I, tail.Len()); !slices.Equal(v, []int{30, 10, 11, 20, 21}) {
+		t.Errorf("tail after split has order %v", v)
+	}
+
+	for _, e := range tail.Clear() {
+		if e.IsLinked() {
+			t.Errorf("cleared element %v is still linked", e.value)
+		}
+	}
+}
+
+func TestSafeSListSplitAfterPanics(t *testing.T) {
+	l := newSafeListGenerate(2, increment(0))
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("SplitAfter of safe-mode SList did not panic")
+		}
+	}()
+	l.SplitAfter(l.Front())
+}
+
+func TestSafeSListForeignSourcePanics(t *testing.T) {
+	l := newSafeListGenerate(2, increment(0))
+	other := newSafeListGenerate(2, increment(10))
+	copied := *other
+	plain := New(func(self *testSafeItem) *Hook[testSafeItem] { return &self.SafeHook.Hook })
+	plainItem := testSafeItem{value: 20}
+	plain.PushBack(&plainItem)
+
+	expectPanic(t, ErrNotMember, func() { l.SpliceAfter(l.Front(), &copied) })
+	expectPanic(t, ErrNotMember, func() { l.SpliceBack(&copied) })
+	expectPanic(t, ErrNotMember, func() { newSafeListGenerate(0, increment(0)).SpliceBack(&copied) })
+	expectPanic(t, ErrNotMember, func() { l.SpliceFront(&copied) })
+	expectPanic(t, ErrNotMember, func() { l.Merge(&copied, func(lhs, rhs *testSafeItem) bool { return lhs.value < rhs.value }) })
+	expectPanic(t, ErrModeMismatch, func() { l.SpliceAfter(l.Front(), &plain) })
+	expectPanic(t, ErrModeMismatch, func() { plain.SpliceBack(l) })
+	expectPanic(t, ErrModeMismatch, func() { l.SpliceAfterRange(nil, &plain, nil, plain.Back()) })
+	expectPanic(t, ErrModeMismatch, func() { l.SplitAfterInto(l.Front(), &plain) })
+	if l.Len() != 2 || other.Len() != 2 || plain.Len() != 1 {
+		t.Errorf("failed operation changed lists %v %v %v", l.Len(), other.Len(), plain.Len())
+	}
+	verifyOwner(t, l)
+	verifyOwner(t, other)
+}
@@ -10,6 +10,9 @@ type (
 	// Head structure of singly-linked list intrusive container
 	SList[T any] struct {
 		hookFunc    func(*T) *Hook[T]
+		offset      uintptr
+		useOffset   bool
+		ownerFunc   func(*T) **SList[T]
 		size        int
 		first, last *T
 	}
@@ -63,9 +66,14 @@ func (s SList[T]) Len() int {
 // Swap content of two SList heads
 func (s *SList[T]) Swap(other *SList[T]) {
 	other.hookFunc, s.hookFunc = s.hookFunc, other.hookFunc
+	other.offset, s.offset = s.offset, other.offset
+	other.useOffset, s.useOffset = s.useOffset, other.useOffset
+	other.ownerFunc, s.ownerFunc = s.ownerFunc, other.ownerFunc
 	other.first, s.first = s.first, other.first
 	other.last, s.last = s.last, other.last
 	other.size, s.size = s.size, other.size
+	s.setOwner(s.first, s.last, s)
+	other.setOwner(other.first, other.last, other)
 }
 
 // Return first element in SList
@@ -78,8 +86,22 @@ func (s SList[T]) Back() *T {
 	return s.last
 }
 
+// Return element after specified one or nil if element is the last one in SList
+func (s SList[T]) Next(element *T) *T {
+	s.verifyIsMemberOfCurrent(element)
+	return s.hook(element).next
+}
+
+// Return copy of hook of element. Unlike Next it does not run debug verifiers,
+// so it MAY be used to inspect damaged SList
+func (s SList[T]) Hook(element *T) Hook[T] {
+	return *s.hook(element)
+}
+
 // Insert new element after specified. Position SHOULD be part of current SList
 func (s *SList[T]) InsertAfter(position, element *T) {
+	s.checkNotLinked(element)
+	s.checkIsMember(position)
 	s.verifyNotEmpty()
 	s.verifyElementNotLinked(element)
 	s.verifyIsMemberOfCurrent(position)
@@ -87,28 +109,31 @@ func (s *SList[T]) InsertAfter(position, element *T) {
 	defer s.verifyNoCycle()
 	defer s.verifySize()
 
-	s.hookFunc(element).next = s.hookFunc(position).next
-	s.hookFunc(position).next = element
+	s.hook(element).next = s.hook(position).next
+	s.hook(position).next = element
 	if s.last == position {
 		s.last = element
 	}
 	s.size++
+	s.setOwner(element, element, s)
 }
 
 // Unlink and return element after specified. Position SHOULD be part of current SList. Return nil if position is at the end of SList
 func (s *SList[T]) RemoveAfter(position *T) (popped *T) {
+	s.checkIsMember(position)
 	s.verifyNotEmpty()
 	s.verifyNoCycle()
 	s.verifyIsMemberOfCurrent(position)
 	defer s.verifySize()
 
-	if popped = s.hookFunc(position).next; popped != nil {
-		s.hookFunc(position).next = s.hookFunc(popped).next
+	if popped = s.hook(position).next; popped != nil {
+		s.hook(position).next = s.hook(popped).next
 		if s.last == popped {
 			s.last = position
 		}
 		s.size--
-		s.hookFunc(popped).Init()
+		s.hook(popped).Init()
+		s.setOwner(popped, popped, nil)
 	}
 	return
 }
@@ -118,6 +143,8 @@ func (s *SList[T]) SpliceAfter(position *T, other *SList[T]) {
 	if other.first == nil {
 		return
 	}
+	s.checkIsMember(position)
+	s.checkSource(other)
 	other.verifyNotEmpty()
 	other.verifyNoCycle()
 	s.verifyNotEmpty()
@@ -126,8 +153,14 @@ func (s *SList[T]) SpliceAfter(position *T, other *SList[T]) {
 	defer s.verifyNoCycle()
 	defer s.verifySize()
 
-	s.hookFunc(other.last).next = s.hookFunc(position).next
-	s.hookFunc(position).next = other.first
+	s.spliceAfter(position, other)
+}
+
+// Link all elements of non-empty other SList after position without checks
+func (s *SList[T]) spliceAfter(position *T, other *SList[T]) {
+	s.setOwner(other.first, other.last, s)
+	s.hook(other.last).next = s.hook(position).next
+	s.hook(position).next = other.first
 	if s.last == position {
 		s.last = other.last
 	}
@@ -135,8 +168,133 @@ func (s *SList[T]) SpliceAfter(position *T, other *SList[T]) {
 	other.Init()
 }
 
+// Move elements in range (beforeFirst, last] from other SList to be included in current SList after position.
+// Position nil means the front of current SList and beforeFirst nil means the range starts at the front of other.
+// Other MAY be current SList if position is not inside the range.
+//
+// Complexity is linear in size of the range if other is not current SList and constant otherwise
+func (s *SList[T]) SpliceAfterRange(position *T, other *SList[T], beforeFirst, last *T) {
+	if beforeFirst == last || s == other && position == beforeFirst {
+		return
+	}
+	s.checkSource(other)
+	other.checkIsMember(last)
+	if beforeFirst != nil {
+		other.checkIsMember(beforeFirst)
+	}
+	if position != nil {
+		s.checkIsMember(position)
+	}
+	other.verifyNotEmpty()
+	other.verifyIsRangeOfCurrent(beforeFirst, last)
+	if s == other {
+		s.verifyNotInRange(beforeFirst, last, position)
+	} else if position != nil {
+		s.verifyIsMemberOfCurrent(position)
+	}
+	defer s.verifyNoCycle()
+	defer s.verifySize()
+	defer other.verifyNoCycle()
+	defer other.verifySize()
+
+	first := other.first
+	if beforeFirst != nil {
+		first = s.hook(beforeFirst).next
+	}
+
+	count := 0
+	if s != other {
+		count = 1
+		for e := first; e != last; e = s.hook(e).next {
+			count++
+		}
+		s.setOwner(first, last, s)
+	}
+
+	// Unlink range from other
+	if beforeFirst == nil {
+		other.first = s.hook(last).next
+	} else {
+		s.hook(beforeFirst).next = s.hook(last).next
+	}
+	if other.last == last {
+		other.last = beforeFirst
+	}
+	other.size -= count
+
+	// Link range after position
+	if position == nil {
+		s.hook(last).next = s.first
+		s.first = first
+		if s.last == nil {
+			s.last = last
+		}
+	} else {
+		s.hook(last).next = s.hook(position).next
+		s.hook(position).next = first
+		if s.last == position {
+			s.last = last
+		}
+	}
+	s.size += count
+}
+
+// Cut current SList in two after position and return all elements after position as new SList.
+// Position nil means all elements are moved to the new SList.
+//
+// SList created with NewSafe can't be split by this function as returned head is a copy:
+// use SplitAfterInto instead.
+//
+// Complexity is linear in number of elements up to position
+func (s *SList[T]) SplitAfter(position *T) (tail SList[T]) {
+	if s.ownerFunc != nil {
+		panic("slist: SplitAfter is not supported by safe-mode SList, use SplitAfterInto")
+	}
+	tail = SList[T]{hookFunc: s.hookFunc, offset: s.offset, useOffset: s.useOffset}
+	s.SplitAfterInto(position, &tail)
+	return
+}
+
+// Cut current SList in two after position and move all elements after position
+// to the end of tail. Position nil means all elements are moved to tail.
+// Tail MUST NOT be current SList and SHOULD be created the same way as current SList.
+//
+// Complexity is linear in number of elements up to position
+func (s *SList[T]) SplitAfterInto(position *T, tail *SList[T]) {
+	tail.checkSource(s)
+	if position == nil {
+		tail.SpliceBack(s)
+		return
+	}
+	s.checkIsMember(position)
+	s.verifyIsMemberOfCurrent(position)
+	defer s.verifyNoCycle()
+	defer s.verifySize()
+
+	count := 1
+	for e := s.first; e != position; e = s.hook(e).next {
+		count++
+	}
+	if s.hook(position).next == nil {
+		return
+	}
+	other := SList[T]{hookFunc: s.hookFunc, ownerFunc: s.ownerFunc, offset: s.offset, useOffset: s.useOffset}
+	other.first = s.hook(position).next
+	other.last = s.last
+	other.size = s.size - count
+	s.hook(position).next = nil
+	s.last = position
+	s.size = count
+	if tail.first == nil {
+		tail.Swap(&other)
+	} else {
+		tail.spliceAfter(tail.last, &other)
+	}
+}
+
 // Insert new element at the front of SList
 func (s *SList[T]) PushFront(element *T) {
+	s.checkNotLinked(element)
 	s.verifyElementNotLinked(element)
 	defer s.verifyIsMemberOfCurrent(element)
 	defer s.verifyNoCycle()
@@ -145,10 +303,11 @@ func (s *SList[T]) PushFront(element *T) {
 	if s.first == nil {
 		s.last = element
 	} else {
-		s.hookFunc(element).next = s.first
+		s.hook(element).next = s.first
 	}
 	s.first = element
 	s.size++
+	s.setOwner(element, element, s)
 }
 
 // Remove and return element from the front of a SList
@@ -166,10 +325,11 @@ func (s *SList[T]) PopFront() (popped *T) {
 		s.first = nil
 		s.last = nil
 	} else {
-		s.first = s.hookFunc(popped).next
+		s.first = s.hook(popped).next
 	}
 	s.size--
-	s.hookFunc(popped).Init()
+	s.hook(popped).Init()
+	s.setOwner(popped, popped, nil)
 	return
 }
 
@@ -181,6 +341,7 @@ func (s *SList[T]) SpliceFront(other *SList[T]) {
 
 // Insert new element into the tail of current SList
 func (s *SList[T]) PushBack(element *T) {
+	s.checkNotLinked(element)
 	s.verifyElementNotLinked(element)
 	defer s.verifyIsMemberOfCurrent(element)
 	defer s.verifyNoCycle()
@@ -189,16 +350,18 @@ func (s *SList[T]) PushBack(element *T) {
 	if s.last == nil {
 		s.first = element
 	} else {
-		s.hookFunc(s.last).next = element
+		s.hook(s.last).next = element
 	}
 	s.last = element
 	s.size++
-	s.hookFunc(element).Init()
+	s.hook(element).Init()
+	s.setOwner(element, element, s)
 }
 
 // Move elements from other SList to be included at the tail of current SList
 func (s *SList[T]) SpliceBack(other *SList[T]) {
 	if s.first == nil {
+		s.checkSource(other)
 		s.Swap(other)
 		return
 	}
@@ -214,7 +377,8 @@ func (s *SList[T]) Clear() (elements []*T) {
 	e := s.first
 	for e != nil {
 		elements = append(elements, e)
-		h := s.hookFunc(e)
+		h := s.hook(e)
+		s.setOwner(e, e, nil)
 		e = h.Next()
 		h.Init()
 	}
@@ -231,8 +395,8 @@ func (s *SList[T]) Reverse() {
 	var prev *T = nil
 	e := s.first
 	for e != nil {
-		next := s.hookFunc(e).next
-		s.hookFunc(e).next = prev
+		next := s.hook(e).next
+		s.hook(e).next = prev
 		prev = e
 		e = next
 	}
@@ -241,13 +405,13 @@ func (s *SList[T]) Reverse() {
 
 func (s *SList[T]) median(l *T) (slow *T) {
 	slow = l
-	fast := s.hookFunc(l).next
+	fast := s.hook(l).next
 
 	for fast != nil {
-		fast = s.hookFunc(fast).next
+		fast = s.hook(fast).next
 		if fast != nil {
-			slow = s.hookFunc(slow).next
-			fast = s.hookFunc(fast).next
+			slow = s.hook(slow).next
+			fast = s.hook(fast).next
 		}
 	}
 	return
@@ -263,43 +427,47 @@ func (s *SList[T]) Median() (median *T) {
 	median = s.first
 	half := (s.size + s.size%2) / 2
 	for i := 0; i < half-1; i++ {
-		median = s.hookFunc(median).next
+		median = s.hook(median).next
 	}
 	return
 }
 
 func (s *SList[T]) merge(a, b *T, less func(lhs, rhs *T) bool) (first, last *T) {
-	if a != nil && (b != nil && less(a, b) || b == nil) {
+	if a != nil && (b == nil || !less(b, a)) {
 		first = a
-		a = s.hookFunc(a).next
+		a = s.hook(a).next
 	} else {
 		first = b
 		if b != nil {
-			b = s.hookFunc(b).next
+			b = s.hook(b).next
 		}
 	}
 	last = first
 	for a != nil || b != nil {
-		if a != nil && (b != nil && less(a, b) || b == nil) {
-			s.hookFunc(last).next = a
+		if a != nil && (b == nil || !less(b, a)) {
+			s.hook(last).next = a
 			last = a
-			a = s.hookFunc(a).next
+			a = s.hook(a).next
 		} else {
-			s.hookFunc(last).next = b
+			s.hook(last).next = b
 			last = b
-			b = s.hookFunc(b).next
+			b = s.hook(b).next
 		}
 	}
 	return
 }
 
-// Merge sorted lists into current SList
+// Merge sorted lists into current SList.
+//
+// Merge is stable: elements of current SList precede equal elements of other
 func (s *SList[T]) Merge(other *SList[T], less func(lhs, rhs *T) bool) {
 	s.verifyNoCycle()
 	other.verifyNoCycle()
 	defer s.verifyNoCycle()
 	defer s.verifySize()
 
+	s.checkSource(other)
+	s.setOwner(other.first, other.last, s)
 	s.first, s.last = s.merge(s.first, other.first, less)
 	s.size += other.size
 	other.Init()
@@ -307,15 +475,15 @@ func (s *SList[T]) Merge(other *SList[T], less func(lhs, rhs *T) bool) {
 
 // Merge sort based implementation
 func (s *SList[T]) sort(head *T, less func(lhs, rhs *T) bool) (first, last *T) {
-	if head == nil || s.hookFunc(head).next == nil {
+	if head == nil || s.hook(head).next == nil {
 		first = head
 		last = first
 		return
 	}
 
 	m := s.median(head)
-	tail := s.hookFunc(m).next
-	s.hookFunc(m).next = nil
+	tail := s.hook(m).next
+	s.hook(m).next = nil
 
 	head, _ = s.sort(head, less)
 	tail, _ = s.sort(tail, less)
@@ -324,7 +492,9 @@ func (s *SList[T]) sort(head *T, less func(lhs, rhs *T) bool) (first, last *T) {
 	return
 }
 
-// Sort current SList in place
+// Sort current SList in place.
+//
+// Sort is stable: equal elements retain their relative order
 func (s *SList[T]) Sort(less func(lhs, rhs *T) bool) {
 	if s.first == nil || s.first == s.last {
 		return
@@ -336,8 +506,8 @@ func (s *SList[T]) Sort(less func(lhs, rhs *T) bool) {
 
 	// One iteration here just will be a bit faster as Median is based on size
 	m := s.Median()
-	tail := s.hookFunc(m).next
-	s.hookFunc(m).next = nil
+	tail := s.hook(m).next
+	s.hook(m).next = nil
 
 	head, _ := s.sort(s.first, less)
 	tail, _ = s.sort(tail, less)
@@ -352,13 +522,13 @@ func (s *SList[T]) adjacent(f func(prev, cur *T)) {
 	s.verifyNotEmpty()
 
 	var p *T = s.first
-	e := s.hookFunc(p).next
+	e := s.hook(p).next
 	for e != nil {
 		f(p, e)
-		n := s.hookFunc(p).next
+		n := s.hook(p).next
 		if n == e {
 			p = e
-			e = s.hookFunc(e).next
+			e = s.hook(e).next
 		} else {
 			e = n
 		}
@@ -387,7 +557,7 @@ func (s *SList[T]) RemoveIf(predicate func(value *T) bool) (elements []*T) {
 	}
 	front := s.first
 	for front != nil && predicate(front) {
-		front = s.hookFunc(front).next
+		front = s.hook(front).next
 		elements = append(elements, s.PopFront())
 	}
 	if front != nil {
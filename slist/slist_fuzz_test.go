@@ -53,6 +53,10 @@ func elementAt(l SList[fuzzEmbedItem], pos int) (r *fuzzEmbedItem) {
 }
 
 func verifyListConsistency(t *testing.T, l *SList[fuzzEmbedItem]) {
+	if err := l.Validate(); err != nil {
+		t.Errorf("Validate failed: %v", err)
+	}
+
 	if l.Empty() {
 		if l.Front() != nil || l.Back() != nil || l.Size() != 0 {
 			t.Errorf("Empty list inconsistency: front=%v, back=%v, size=%d", l.Front(), l.Back(), l.Size())
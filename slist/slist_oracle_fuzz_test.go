@@ -0,0 +1,229 @@
+package slist
+
+import (
+	"testing"
+
+	"github.com/echo-Mike/intrusive/internal/pkg/oracle"
+)
+
+const (
+	oracleOpInsertAfter byte = iota
+	oracleOpRemoveAfter
+	oracleOpSpliceAfter
+	oracleOpSpliceAfterRange
+	oracleOpSplitAfterInto
+	oracleOpPushFront
+	oracleOpPopFront
+	oracleOpSpliceFront
+	oracleOpPushBack
+	oracleOpSpliceBack
+	oracleOpClear
+	oracleOpReverse
+	oracleOpMerge
+	oracleOpSort
+	oracleOpUnique
+	oracleOpRemoveIf
+	oracleOpCOUNT
+)
+
+func fuzzPredicate(kind byte) func(*fuzzEmbedItem) bool {
+	switch kind % 4 {
+	case 0:
+		return func(e *fuzzEmbedItem) bool { return e.value%2 == 0 }
+	case 1:
+		return func(e *fuzzEmbedItem) bool { return e.value%2 == 1 }
+	case 2:
+		return func(e *fuzzEmbedItem) bool { return e.value < 8 }
+	default:
+		return func(e *fuzzEmbedItem) bool { return e.value >= 8 }
+	}
+}
+
+func verifyRemoved(t *testing.T, got, want []*fuzzEmbedItem) {
+	if diff := oracle.Diff(got, want); diff != "" {
+		t.Fatalf("removed elements differ from model: %s", diff)
+	}
+}
+
+// Apply command to SList and to its reference model
+func oracleStep(t *testing.T, items []fuzzEmbedItem, lists []SList[fuzzEmbedItem], models []oracle.List[*fuzzEmbedItem]) func(command []byte) {
+	return func(command []byte) {
+		op, arg1, arg2, arg3, arg4, arg5 := command[0], int(command[1]), int(command[2]), int(command[3]), int(command[4]), int(command[5])
+		listIdx, otherIdx := arg1%len(lists), arg2%len(lists)
+		l, m := &lists[listIdx], &models[listIdx]
+		other, otherModel := &lists[otherIdx], &models[otherIdx]
+		item := &items[arg2%len(items)]
+		at := arg3 % (m.Len() + 1)
+
+		switch op % oracleOpCOUNT {
+		case oracleOpInsertAfter:
+			if pos := m.At(at); pos != nil && !item.isUsed {
+				l.InsertAfter(pos, item)
+				m.Insert(at+1, item)
+				item.isUsed = true
+			}
+
+		case oracleOpRemoveAfter:
+			if pos := m.At(at); pos != nil {
+				var want *fuzzEmbedItem
+				if at+1 < m.Len() {
+					want = m.RemoveAt(at + 1)
+				}
+				if e := l.RemoveAfter(pos); e != want {
+					t.Fatalf("RemoveAfter returned %v while model has %v", e, want)
+				} else if e != nil {
+					e.isUsed = false
+				}
+			}
+
+		case oracleOpSpliceAfter:
+			if pos := m.At(at); pos != nil && l != other {
+				l.SpliceAfter(pos, other)
+				m.Splice(at+1, otherModel)
+			}
+
+		case oracleOpSpliceAfterRange:
+			if otherModel.Len() == 0 {
+				break
+			}
+			// Range is (beforeFirst, last] where index -1 stands for nil
+			beforeFirst := arg4%otherModel.Len() - 1
+			last := beforeFirst + 1 + arg5%(otherModel.Len()-beforeFirst-1)
+			position := at - 1
+			pos := m.At(position)
+			if l == other && position > beforeFirst && position <= last {
+				break
+			}
+			l.SpliceAfterRange(pos, other, otherModel.At(beforeFirst), otherModel.At(last))
+			if l == other && position == beforeFirst {
+				break
+			}
+			moved := otherModel.Cut(beforeFirst+1, last+1)
+			m.InsertAll(m.Index(pos)+1, moved)
+
+		case oracleOpSplitAfterInto:
+			if l != other {
+				pos := m.At(at - 1)
+				l.SplitAfterInto(pos, other)
+				otherModel.InsertAll(otherModel.Len(), m.Cut(at, m.Len()))
+			}
+
+		case oracleOpPushFront:
+			if !item.isUsed {
+				l.PushFront(item)
+				m.PushFront(item)
+				item.isUsed = true
+			}
+
+		case oracleOpPopFront:
+			if e := l.PopFront(); e != nil {
+				e.isUsed = false
+			}
+			m.PopFront()
+
+		case oracleOpSpliceFront:
+			if l != other {
+				l.SpliceFront(other)
+				m.Splice(0, otherModel)
+			}
+
+		case oracleOpPushBack:
+			if !item.isUsed {
+				l.PushBack(item)
+				m.PushBack(item)
+				item.isUsed = true
+			}
+
+		case oracleOpSpliceBack:
+			if l != other {
+				l.SpliceBack(other)
+				m.Splice(m.Len(), otherModel)
+			}
+
+		case oracleOpClear:
+			removed := l.Clear()
+			for _, e := range removed {
+				e.isUsed = false
+			}
+			verifyRemoved(t, removed, m.Clear())
+
+		case oracleOpReverse:
+			l.Reverse()
+			m.Reverse()
+
+		case oracleOpMerge:
+			if l != other {
+				l.Sort(lessFuzz)
+				other.Sort(lessFuzz)
+				l.Merge(other, lessFuzz)
+				m.Sort(lessFuzz)
+				otherModel.Sort(lessFuzz)
+				m.Merge(otherModel, lessFuzz)
+			}
+
+		case oracleOpSort:
+			l.Sort(lessFuzz)
+			m.Sort(lessFuzz)
+
+		case oracleOpUnique:
+			if arg3%2 == 0 {
+				l.Sort(lessFuzz)
+				m.Sort(lessFuzz)
+			}
+			removed := l.Unique(lessFuzz)
+			for _, e := range removed {
+				e.isUsed = false
+			}
+			verifyRemoved(t, removed, m.Unique(lessFuzz))
+
+		case oracleOpRemoveIf:
+			removed := l.RemoveIf(fuzzPredicate(command[3]))
+			for _, e := range removed {
+				e.isUsed = false
+			}
+			verifyRemoved(t, removed, m.RemoveIf(fuzzPredicate(command[3])))
+		}
+	}
+}
+
+// Compare full contents of every SList with its reference model
+func oracleCheck(t *testing.T, lists []SList[fuzzEmbedItem], models []oracle.List[*fuzzEmbedItem]) func(step int) {
+	return func(step int) {
+		for i := range lists {
+			l, want := &lists[i], models[i].Elements()
+			got := oracle.Collect(l.Front(), func(e *fuzzEmbedItem) *fuzzEmbedItem { return e.Next() })
+			if diff := oracle.Diff(got, want); diff != "" {
+				t.Fatalf("step %d: list %d differs from model: %s", step, i, diff)
+			}
+			if l.Len() != len(want) {
+				t.Fatalf("step %d: list %d has size %d while model has %d", step, i, l.Len(), len(want))
+			}
+			if len(want) != 0 && l.Back() != want[len(want)-1] {
+				t.Fatalf("step %d: list %d has back %v while model has %v", step, i, l.Back(), want[len(want)-1])
+			}
+		}
+	}
+}
+
+func FuzzSListOracle(f *testing.F) {
+	const numItems = 64
+	const numLists = 4
+
+	for _, seed := range oracle.Seeds(32, 512) {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, commands []byte) {
+		items := make([]fuzzEmbedItem, numItems)
+		for i := range items {
+			items[i] = newFuzz(i%16, i)
+		}
+		lists := make([]SList[fuzzEmbedItem], numLists)
+		for i := range lists {
+			lists[i] = newFuzzList()
+		}
+		models := make([]oracle.List[*fuzzEmbedItem], numLists)
+
+		oracle.Replay(commands, 6, oracleStep(t, items, lists, models), oracleCheck(t, lists, models))
+	})
+}
@@ -1,6 +1,7 @@
 package slist
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/echo-Mike/intrusive/internal/pkg/fn"
@@ -1070,6 +1071,14 @@ func TestThreeElementListNextWillIterateOverAllElements(t *testing.T) {
 	}
 }
 
+func TestThreeElementListHookReturnsLinks(t *testing.T) {
+	m := newMemberListGenerate(3, increment(0))
+	middle := m.Hook(m.Front()).Next()
+	if middle != m.Front().hook.Next() || m.Hook(middle).Next() != m.Back() || m.Hook(m.Back()).Next() != nil {
+		t.Errorf("member element list hook links are not the same as in element")
+	}
+}
+
 func TestThreeElementListHasCorrectSize(t *testing.T) {
 	e := newEmbedListGenerate(3, increment(0))
 	if s := e.Len(); s != 3 {
@@ -2041,3 +2050,214 @@ func TestListRemoveIfNotFromFront(t *testing.T) {
 		})
 	}
 }
+
+func elementAtEmbed(l SList[testEmbedItem], pos int) (e *testEmbedItem) {
+	if pos < 0 {
+		return nil
+	}
+	e = l.Front()
+	for i := 0; i < pos; i++ {
+		e = e.Next()
+	}
+	return
+}
+
+func elementAtMember(l SList[testMemberItem], pos int) (m *testMemberItem) {
+	if pos < 0 {
+		return nil
+	}
+	m = l.Front()
+	for i := 0; i < pos; i++ {
+		m = m.hook.Next()
+	}
+	return
+}
+
+func TestTwoListsSpliceAfterRangeMovesRange(t *testing.T) {
+	// Negative position means nil
+	tests := map[string]struct {
+		listSizes         [2]int
+		at                int
+		beforeFirst, last int
+		order, otherOrder []int
+	}{
+		"whole-into-empty": {[2]int{0, 3}, -1, -1, 2, []int{10, 11, 12}, []int{}},
+		"prefix-to-front":  {[2]int{2, 3}, -1, -1, 1, []int{10, 11, 0, 1}, []int{12}},
+		"prefix-to-back":   {[2]int{2, 3}, 1, -1, 0, []int{0, 1, 10}, []int{11, 12}},
+		"suffix-to-middle": {[2]int{2, 3}, 0, 0, 2, []int{0, 11, 12, 1}, []int{10}},
+		"middle-to-back":   {[2]int{2, 3}, 1, 0, 1, []int{0, 1, 11}, []int{10, 12}},
+		"empty-range":      {[2]int{2, 3}, 1, 1, 1, []int{0, 1}, []int{10, 11, 12}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedListGenerate(testCase.listSizes[0], increment(0))
+			eo := newEmbedListGenerate(testCase.listSizes[1], increment(10))
+			e.SpliceAfterRange(elementAtEmbed(e, testCase.at), &eo, elementAtEmbed(eo, testCase.beforeFirst), elementAtEmbed(eo, testCase.last))
+			if v := fn.Apply(nextEmbed(e), fn.I, e.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element list after spliceafterrange has order %v", v)
+			}
+			if v := fn.Apply(nextEmbed(eo), fn.I, eo.Len()); !slices.Equal(v, testCase.otherOrder) {
+				t.Errorf("embedded element other list after spliceafterrange has order %v", v)
+			}
+			if e.Len() > 0 && (e.Back().value != testCase.order[len(testCase.order)-1] || e.Back().Next() != nil) {
+				t.Errorf("embedded element list after spliceafterrange has wrong back")
+			}
+			if eo.Len() > 0 && eo.Back().value != testCase.otherOrder[len(testCase.otherOrder)-1] {
+				t.Errorf("embedded element other list after spliceafterrange has wrong back")
+			}
+			if eo.Len() == 0 && (eo.Front() != nil || eo.Back() != nil) {
+				t.Errorf("embedded element other list after spliceafterrange is not empty")
+			}
+
+			m := newMemberListGenerate(testCase.listSizes[0], increment(0))
+			mo := newMemberListGenerate(testCase.listSizes[1], increment(10))
+			m.SpliceAfterRange(elementAtMember(m, testCase.at), &mo, elementAtMember(mo, testCase.beforeFirst), elementAtMember(mo, testCase.last))
+			if v := fn.Apply(nextMember(m), fn.I, m.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("member element list after spliceafterrange has order %v", v)
+			}
+			if v := fn.Apply(nextMember(mo), fn.I, mo.Len()); !slices.Equal(v, testCase.otherOrder) {
+				t.Errorf("member element other list after spliceafterrange has order %v", v)
+			}
+		})
+	}
+}
+
+func TestSpliceAfterRangeWithinListMovesRange(t *testing.T) {
+	// Negative position means nil
+	tests := map[string]struct {
+		at                int
+		beforeFirst, last int
+		order             []int
+	}{
+		"to-front":        {-1, 1, 3, []int{2, 3, 0, 1, 4}},
+		"front-to-back":   {4, -1, 1, []int{2, 3, 4, 0, 1}},
+		"back-to-front":   {-1, 2, 4, []int{3, 4, 0, 1, 2}},
+		"same-place":      {1, 1, 3, []int{0, 1, 2, 3, 4}},
+		"front-in-place":  {-1, -1, 1, []int{0, 1, 2, 3, 4}},
+		"forward-middle":  {3, 0, 1, []int{0, 2, 3, 1, 4}},
+		"backward-middle": {0, 2, 3, []int{0, 3, 1, 2, 4}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedListGenerate(5, increment(0))
+			e.SpliceAfterRange(elementAtEmbed(e, testCase.at), &e, elementAtEmbed(e, testCase.beforeFirst), elementAtEmbed(e, testCase.last))
+			if e.Len() != 5 {
+				t.Errorf("embedded element list after spliceafterrange has size %v", e.Len())
+			}
+			if v := fn.Apply(nextEmbed(e), fn.I, e.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("embedded element list after spliceafterrange has order %v", v)
+			}
+			if e.Back().value != testCase.order[4] || e.Back().Next() != nil {
+				t.Errorf("embedded element list after spliceafterrange has wrong back")
+			}
+
+			m := newMemberListGenerate(5, increment(0))
+			m.SpliceAfterRange(elementAtMember(m, testCase.at), &m, elementAtMember(m, testCase.beforeFirst), elementAtMember(m, testCase.last))
+			if v := fn.Apply(nextMember(m), fn.I, m.Len()); !slices.Equal(v, testCase.order) {
+				t.Errorf("member element list after spliceafterrange has order %v", v)
+			}
+		})
+	}
+}
+
+func TestSplitAfterCutsList(t *testing.T) {
+	// Negative position means nil
+	tests := map[string]struct {
+		listSize   int
+		at         int
+		head, tail []int
+	}{
+		"empty-nil":  {0, -1, []int{}, []int{}},
+		"nil":        {3, -1, []int{}, []int{0, 1, 2}},
+		"front":      {3, 0, []int{0}, []int{1, 2}},
+		"middle":     {3, 1, []int{0, 1}, []int{2}},
+		"back":       {3, 2, []int{0, 1, 2}, []int{}},
+		"single-one": {1, 0, []int{0}, []int{}},
+	}
+	for name, testCase := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := newEmbedListGenerate(testCase.listSize, increment(0))
+			et := e.SplitAfter(elementAtEmbed(e, testCase.at))
+			if v := fn.Apply(nextEmbed(e), fn.I, e.Len()); !slices.Equal(v, testCase.head) {
+				t.Errorf("embedded element list after splitafter has order %v", v)
+			}
+			if v := fn.Apply(nextEmbed(et), fn.I, et.Len()); !slices.Equal(v, testCase.tail) {
+				t.Errorf("embedded element tail list after splitafter has order %v", v)
+			}
+			if e.Len() > 0 && e.Back().Next() != nil {
+				t.Errorf("embedded element list after splitafter back is linked to tail")
+			}
+			if et.Len() > 0 && et.Back().value != testCase.tail[len(testCase.tail)-1] {
+				t.Errorf("embedded element tail list after splitafter has wrong back")
+			}
+
+			m := newMemberListGenerate(testCase.listSize, increment(0))
+			mt := m.SplitAfter(elementAtMember(m, testCase.at))
+			if v := fn.Apply(nextMember(m), fn.I, m.Len()); !slices.Equal(v, testCase.head) {
+				t.Errorf("member element list after splitafter has order %v", v)
+			}
+			if v := fn.Apply(nextMember(mt), fn.I, mt.Len()); !slices.Equal(v, testCase.tail) {
+				t.Errorf("member element tail list after splitafter has order %v", v)
+			}
+		})
+	}
+}
+
+type testStableItem struct {
+	Hook[testStableItem]
+	key, order int
+}
+
+func stableHook(self *testStableItem) *Hook[testStableItem] {
+	return &self.Hook
+}
+
+func newStableListGenerate(count int, key func(position int) int) (l SList[testStableItem], items []testStableItem) {
+	l = New(stableHook)
+	items = make([]testStableItem, count)
+	for i := range items {
+		items[i] = testStableItem{key: key(i), order: i}
+		l.PushBack(&items[i])
+	}
+	return
+}
+
+func verifyStableOrder(t *testing.T, l SList[testStableItem], size int) {
+	if l.Len() != size {
+		t.Errorf("list has size %v instead of %v", l.Len(), size)
+	}
+	count := 0
+	var prev *testStableItem
+	for e := l.Front(); e != nil; e = e.Next() {
+		if prev != nil && (prev.key > e.key || prev.key == e.key && prev.order > e.order) {
+			t.Errorf("elements are not stably sorted: (%v, %v) before (%v, %v)", prev.key, prev.order, e.key, e.order)
+		}
+		prev = e
+		count++
+	}
+	if prev != l.Back() || count != size {
+		t.Errorf("list back or traversed size %v is incorrect", count)
+	}
+}
+
+func TestSListSortIsStable(t *testing.T) {
+	for _, size := range []int{2, 3, 7, 16, 33, 100, 1000} {
+		l, _ := newStableListGenerate(size, func(p int) int { return (p * 7919) % 5 })
+		l.Sort(func(lhs, rhs *testStableItem) bool { return lhs.key < rhs.key })
+		verifyStableOrder(t, l, size)
+	}
+}
+
+func TestSListMergeIsStable(t *testing.T) {
+	l, _ := newStableListGenerate(6, func(p int) int { return p / 2 })
+	o := New(stableHook)
+	items := []testStableItem{{key: 0, order: 10}, {key: 1, order: 11}, {key: 3, order: 12}}
+	for i := range items {
+		o.PushBack(&items[i])
+	}
+	l.Merge(&o, func(lhs, rhs *testStableItem) bool { return lhs.key < rhs.key })
+	verifyStableOrder(t, l, 9)
+	if !o.Empty() {
+		t.Errorf("merged list is not empty")
+	}
+}
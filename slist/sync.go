@@ -0,0 +1,61 @@
+package slist
+
+import (
+	"github.com/echo-Mike/intrusive/internal/pkg/guard"
+)
+
+type (
+	// SList guarded by read-write mutex that can be shared between goroutines.
+	//
+	// Hooks of linked elements are modified only under write lock, so elements
+	// MUST NOT be accessed through their hooks without holding the lock (see Do and View).
+	// Len and Empty don't take the lock.
+	// SyncSList MUST NOT be copied after first use
+	SyncSList[T any] struct {
+		guard.Guarded[*SList[T]]
+		list SList[T]
+	}
+)
+
+// Create new SyncSList taking ownership of list. List SHOULD be empty as elements
+// of list created with NewSafe store the address of its head
+func NewSync[T any](list SList[T]) *SyncSList[T] {
+	s := &SyncSList[T]{list: list}
+	s.list.setOwner(s.list.first, s.list.last, &s.list)
+	guard.Init(&s.Guarded, &s.list)
+	return s
+}
+
+// Return first element in SList
+func (s *SyncSList[T]) Front() (front *T) {
+	s.View(func(list *SList[T]) { front = list.Front() })
+	return
+}
+
+// Return last element in SList
+func (s *SyncSList[T]) Back() (back *T) {
+	s.View(func(list *SList[T]) { back = list.Back() })
+	return
+}
+
+// Insert new element at the front of SList
+func (s *SyncSList[T]) PushFront(element *T) {
+	s.Do(func(list *SList[T]) { list.PushFront(element) })
+}
+
+// Insert new element at the back of SList
+func (s *SyncSList[T]) PushBack(element *T) {
+	s.Do(func(list *SList[T]) { list.PushBack(element) })
+}
+
+// Unlink and return first element of SList or nil if SList is empty
+func (s *SyncSList[T]) PopFront() (popped *T) {
+	s.Do(func(list *SList[T]) { popped = list.PopFront() })
+	return
+}
+
+// Remove all elements from SList and return them as slice
+func (s *SyncSList[T]) Clear() (elements []*T) {
+	s.Do(func(list *SList[T]) { elements = list.Clear() })
+	return
+}
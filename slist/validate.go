@@ -0,0 +1,45 @@
+package slist
+
+// Check integrity of SList and return *ValidationError describing the first detected violation
+// or nil if SList is consistent. Unlike debug verifiers it is available in all builds.
+//
+// Complexity is linear in size of SList
+func (s *SList[T]) Validate() error {
+	if cycle := s.findCycle(); cycle != nil {
+		return &ValidationError[T]{Err: ErrCycle, Element: cycle}
+	}
+
+	count := 0
+	var prev *T = nil
+	for e := s.first; e != nil; e = s.hook(e).next {
+		if s.ownerFunc != nil && *s.ownerFunc(e) != s {
+			return &ValidationError[T]{Err: ErrNotMember, Element: e}
+		}
+		prev = e
+		count++
+	}
+	if s.last != prev {
+		return &ValidationError[T]{Err: ErrBrokenLink, Element: s.last}
+	}
+	if count != s.size {
+		return &ValidationError[T]{Err: ErrSizeMismatch, Element: nil}
+	}
+	return nil
+}
+
+// Find cycle in links of SList using Floyd's algorithm.
+// Return element inside of the cycle or nil if there is no cycle
+func (s *SList[T]) findCycle() *T {
+	slow, fast := s.first, s.first
+	for fast != nil {
+		if fast = s.hook(fast).next; fast == nil {
+			return nil
+		}
+		fast = s.hook(fast).next
+		slow = s.hook(slow).next
+		if fast == slow {
+			return fast
+		}
+	}
+	return nil
+}
@@ -13,13 +13,13 @@ func (s *SList[T]) verifyNotEmpty() {
 }
 
 func (s *SList[T]) verifyElementNotLinked(element *T) {
-	if s.hookFunc(element).next != nil {
+	if s.hook(element).next != nil {
 		panic(fmt.Sprintf("already linked element detected: SList %p element: %p", s, element))
 	}
 }
 
 func (s *SList[T]) verifyIsMemberOfCurrent(element *T) {
-	for e := s.first; e != nil; e = s.hookFunc(e).next {
+	for e := s.first; e != nil; e = s.hook(e).next {
 		if e == element {
 			return
 		}
@@ -33,7 +33,7 @@ func (s *SList[T]) verifySize() {
 		if e == nil {
 			panic(fmt.Sprintf("size of list is less than expected: SList %p", s))
 		}
-		e = s.hookFunc(e).next
+		e = s.hook(e).next
 	}
 	if e != nil {
 		panic(fmt.Sprintf("size of list is greater than expected: SList %p", s))
@@ -42,10 +42,122 @@ func (s *SList[T]) verifySize() {
 
 func (s *SList[T]) verifyNoCycle() {
 	walked := make(map[*T]bool)
-	for e := s.first; e != nil; e = s.hookFunc(e).next {
+	for e := s.first; e != nil; e = s.hook(e).next {
 		if walked[e] {
 			panic(fmt.Sprintf("found a cycle: SList %p", s))
 		}
 		walked[e] = true
 	}
 }
+
+func (s *SList[T]) verifyIsRangeOfCurrent(beforeFirst, last *T) {
+	e := s.first
+	if beforeFirst != nil {
+		s.verifyIsMemberOfCurrent(beforeFirst)
+		e = s.hook(beforeFirst).next
+	}
+	for ; e != nil; e = s.hook(e).next {
+		if e == last {
+			return
+		}
+	}
+	panic(fmt.Sprintf("invalid range detected: SList %p before first: %p last: %p", s, beforeFirst, last))
+}
+
+func (s *SList[T]) verifyNotInRange(beforeFirst, last, element *T) {
+	if element == nil {
+		return
+	}
+	s.verifyIsMemberOfCurrent(element)
+	e := s.first
+	if beforeFirst != nil {
+		e = s.hook(beforeFirst).next
+	}
+	for ; e != nil; e = s.hook(e).next {
+		if e == element {
+			panic(fmt.Sprintf("element inside of range detected: SList %p element: %p", s, element))
+		}
+		if e == last {
+			return
+		}
+	}
+}
+
+func (c *Circular[T]) verifyNotEmpty() {
+	if c.last == nil || c.size == 0 {
+		panic(fmt.Sprintf("unexpected empty list: Circular %p", c))
+	}
+}
+
+func (c *Circular[T]) verifyElementNotLinked(element *T) {
+	if c.hook(element).next != nil {
+		panic(fmt.Sprintf("already linked element detected: Circular %p element: %p", c, element))
+	}
+}
+
+func (c *Circular[T]) verifyIsMemberOfCurrent(element *T) {
+	e := c.last
+	for i := 0; i < c.size; i++ {
+		if e == element {
+			return
+		}
+		e = c.hook(e).next
+	}
+	panic(fmt.Sprintf("not member of detected: Circular %p element: %p", c, element))
+}
+
+func (c *Circular[T]) verifySize() {
+	if c.last == nil {
+		if c.size != 0 {
+			panic(fmt.Sprintf("size of list is greater than expected: Circular %p", c))
+		}
+		return
+	}
+	e := c.hook(c.last).next
+	for i := 1; i < c.size; i++ {
+		if e == c.last {
+			panic(fmt.Sprintf("size of list is less than expected: Circular %p", c))
+		}
+		e = c.hook(e).next
+	}
+	if e != c.last {
+		panic(fmt.Sprintf("size of list is greater than expected: Circular %p", c))
+	}
+}
+
+func (c *Circular[T]) verifyLinks() {
+	e := c.last
+	for i := 0; i < c.size; i++ {
+		if e = c.hook(e).next; e == nil {
+			panic(fmt.Sprintf("broken link detected: Circular %p", c))
+		}
+	}
+	if e != c.last {
+		panic(fmt.Sprintf("list is not closed: Circular %p", c))
+	}
+}
+
+func (l *Linear[T]) verifyElementNotLinked(element *T) {
+	if l.hook(element).next != nil {
+		panic(fmt.Sprintf("already linked element detected: Linear %p element: %p", l, element))
+	}
+}
+
+func (l *Linear[T]) verifyIsMemberOfCurrent(element *T) {
+	for e := l.first; e != nil; e = l.hook(e).next {
+		if e == element {
+			return
+		}
+	}
+	panic(fmt.Sprintf("not member of detected: Linear %p element: %p", l, element))
+}
+
+func (l *Linear[T]) verifyNoCycle() {
+	walked := make(map[*T]bool)
+	for e := l.first; e != nil; e = l.hook(e).next {
+		if walked[e] {
+			panic(fmt.Sprintf("found a cycle: Linear %p", l))
+		}
+		walked[e] = true
+	}
+}
@@ -16,3 +16,33 @@ func (s *SList[T]) verifySize() {
 
 func (s *SList[T]) verifyNoCycle() {
 }
+
+func (s *SList[T]) verifyIsRangeOfCurrent(beforeFirst, last *T) {
+}
+
+func (s *SList[T]) verifyNotInRange(beforeFirst, last, element *T) {
+}
+
+func (c *Circular[T]) verifyNotEmpty() {
+}
+
+func (c *Circular[T]) verifyElementNotLinked(element *T) {
+}
+
+func (c *Circular[T]) verifyIsMemberOfCurrent(element *T) {
+}
+
+func (c *Circular[T]) verifySize() {
+}
+
+func (c *Circular[T]) verifyLinks() {
+}
+
+func (l *Linear[T]) verifyElementNotLinked(element *T) {
+}
+
+func (l *Linear[T]) verifyIsMemberOfCurrent(element *T) {
+}
+
+func (l *Linear[T]) verifyNoCycle() {
+}